@@ -0,0 +1,148 @@
+package basics
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"lets-go/registry"
+	"lets-go/validate"
+	"os"
+	"strings"
+)
+
+/*
+Rejestr z pakietu registry nie zna naszych typów. O tym, co komponent potrafi, dowiaduje się asercjami typu,
+np. c.(registry.Validator) - dokładnie tak jak hello.(string) w lekcji o interfejsach, tylko z interfejsem zamiast
+konkretnego typu. Wystarczy więc dopisać metodę, żeby komponent zyskał nową możliwość.
+*/
+
+// Validate sprawia, że Person spełnia registry.Validator. Reguły są w tagach validate struktury Person.
+func (p Person) Validate() error {
+	return validate.Struct(p)
+}
+
+/*
+Init sprawia, że *SafeCounter spełnia registry.Initializer: wartość zerowa SafeCounter ma mapę nil,
+do której nie można zapisywać, więc Init ją tworzy. Odbiorca musi być wskaźnikiem, żeby zmiana była widoczna -
+i dlatego Initializer implementuje tylko *SafeCounter, a nie SafeCounter.
+*/
+func (c *SafeCounter) Init() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.v = make(map[string]int)
+	return nil
+}
+
+// logger to najprostszy dziennik: Start i Stop zapisują znaczniki, a Close zwraca błąd, jeśli dziennik był już zamknięty.
+type logger struct {
+	prefix string
+	w      io.Writer
+	lines  int
+	closed bool
+}
+
+func (l *logger) Printf(format string, args ...any) {
+	l.lines++
+	fmt.Fprintf(l.w, l.prefix+format+"\n", args...)
+}
+
+func (l *logger) Start() error {
+	l.Printf("started")
+	return nil
+}
+
+func (l *logger) Stop() error {
+	l.Printf("stopped after %d lines", l.lines)
+	return nil
+}
+
+func (l *logger) Close() error {
+	if l.closed {
+		return errors.New("logger already closed")
+	}
+	l.closed = true
+	return nil
+}
+
+func (l *logger) String() string {
+	return fmt.Sprintf("logger %q (%d lines)", strings.TrimSpace(l.prefix), l.lines)
+}
+
+/*
+visits to mała usługa złożona z pozostałych komponentów: wita osobę, liczy wizyty i zapisuje je w dzienniku.
+Dostaje je przez pola, a rejestr dba o to, żeby przed jej Start wszystkie były już zainicjalizowane.
+*/
+type visits struct {
+	person  Person
+	counter *SafeCounter
+	log     *logger
+}
+
+func (s *visits) Start() error {
+	s.counter.Inc(s.person.Name)
+	s.log.Printf("welcome %v, visit #%d", s.person, s.counter.Value(s.person.Name))
+	return nil
+}
+
+func (s *visits) Stop() error {
+	s.log.Printf("goodbye %s", s.person.Name)
+	return nil
+}
+
+func newRegistry(p Person) *registry.Registry {
+	log := &logger{prefix: "  [log] ", w: os.Stdout}
+	counter := &SafeCounter{}
+	r := registry.New()
+	r.Hook = func(name, step string, err error) {
+		if err != nil {
+			fmt.Printf("  %s.%s failed: %v\n", name, step, err)
+		}
+	}
+	// Kolejność rejestracji jest celowo odwrotna - o kolejności uruchamiania decydują zależności.
+	r.Register("visits", &visits{p, counter, log}, "logger", "counter", "person")
+	r.Register("counter", counter, "logger")
+	r.Register("person", p)
+	r.Register("logger", log)
+	return r
+}
+
+func components() {
+	r := newRegistry(Person{"Arthur Dent", 42})
+	order, _ := r.Order()
+	fmt.Println("order:", order)
+	r.Report(os.Stdout)
+	if err := r.Start(); err != nil {
+		fmt.Println("start:", err)
+	}
+	if log, ok := registry.Lookup[fmt.Stringer](r, "logger"); ok {
+		fmt.Println(log)
+	}
+	if err := r.Stop(); err != nil {
+		fmt.Println("stop:", err)
+	}
+
+	/*
+	Metody z odbiorcą wartości należą do zbioru metod T i *T, a metody z odbiorcą wskaźnikowym tylko do *T.
+	Dlatego Person i *Person mają te same możliwości, a logger zarejestrowany jako wartość nie miałby żadnej.
+	*/
+	fmt.Println(" Person:", registry.Capabilities(Person{}))
+	fmt.Println("*Person:", registry.Capabilities(&Person{}))
+	fmt.Println(" logger:", registry.Capabilities(logger{}))
+	fmt.Println("*logger:", registry.Capabilities(&logger{}))
+	fmt.Println("*SafeCounter:", registry.Capabilities(&SafeCounter{}))
+
+	/*
+	Błąd walidacji przerywa Start, a komponenty uruchomione wcześniej (logger, counter) są zatrzymywane,
+	więc nic nie zostaje w połowie uruchomione.
+	*/
+	r = newRegistry(Person{"", 9001})
+	err := r.Start()
+	var ve *validate.ValidationError
+	fmt.Println("start:", err)
+	fmt.Println("validation error:", errors.As(err, &ve))
+
+	r.Register("a", struct{}{}, "b")
+	r.Register("b", struct{}{}, "a")
+	_, err = r.Order()
+	fmt.Println(err, errors.Is(err, registry.ErrCycle))
+}
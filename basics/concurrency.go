@@ -2,17 +2,24 @@ package basics
 
 import (
 	"fmt"
+	"lets-go/crash"
 	"time"
 	"sync"
     "sync/atomic"
 )
 
-func TestConcurrency() {
+/*
+TestConcurrency dostaje Runner z main, bo recover z lekcji (Runner.Run) nie obejmuje gorutyn, które lekcja uruchomi -
+panika w gorutynie bez własnego recover kończy cały program. Dlatego gorutyny z przykładów o kanałach i select
+startujemy przez r.Go; pierwszy przykład zostaje przy zwykłym go, bo to tej instrukcji uczy lekcja.
+Funkcje z dalszych przykładów (worketTest, testTimeouts, ...) nie są wywoływane przez lekcję i używają zwykłego go.
+*/
+func TestConcurrency(r *crash.Runner) {
 	fmt.Println("--concurrency----------------------------------------------------------------------------------------")
-	concurrency()
+	concurrency(r)
 }
 
-func concurrency() {
+func concurrency(r *crash.Runner) {
 	/*
 	W Go gorutyna jest wydajnym wątkiem którym zarządza biblioteka runtime.
 	Ewaluacja parametrów odbywa się w obecnej gorutynie, a wykonanie funkcji już nowej.
@@ -21,6 +28,17 @@ func concurrency() {
 	go say("world")
 	say("hello")
 
+	/*
+	Panika w gorutynie, której nikt nie przechwyci, kończy cały program. r.Go działa jak instrukcja go,
+	ale gorutyna ma własny defer z recover i zgłasza panikę jako raport o awarii:
+
+	go say("world")                               // panika kończy program
+	r.Go("concurrency", func() { say("world") })  // panika trafia do katalogu crashes
+
+	Uwaga: argumenty go say("world") są obliczane od razu, a w domknięciu przekazanym do r.Go - dopiero w nowej gorutynie.
+	Dalsze gorutyny tej lekcji startujemy przez r.Go.
+	*/
+
 	/*
 	Kanały są posiadającymi typ „kablami” przez które możesz wysyłać i odbierać wartości używając operatora <-.
 	ch <- v    // Wysyła v do kanału ch.
@@ -32,8 +50,8 @@ func concurrency() {
 	s := []int{7, 2, 8, -9, 4, 0}
 
 	c := make(chan int) // Tworzy nowy kanał typu int
-	go sum(s[:len(s)/2], c)
-	go sum(s[len(s)/2:], c)
+	r.Go("concurrency", func() { sum(s[:len(s)/2], c) })
+	r.Go("concurrency", func() { sum(s[len(s)/2:], c) })
 	x, y := <-c, <-c // odbiera z c
 
 	fmt.Println(x, y, x+y)
@@ -61,7 +79,7 @@ func concurrency() {
 	gdy odbiorca musi wiedzieć, że więcej wartość nie zostanie już nim przesłanych, na przykład po to by mógł zakończyć działanie wyrażenia range w pętli.
 	*/
 	c2 := make(chan int, 10)
-	go fibonacci(cap(c2), c2)
+	r.Go("concurrency", func() { fibonacci(cap(c2), c2) })
 	for i := range c2 {
 		fmt.Println(i)
 	}
@@ -74,12 +92,12 @@ func concurrency() {
 	*/
 	c3 := make(chan int)
 	quit := make(chan int)
-	go func() {
+	r.Go("concurrency", func() {
 		for i := 0; i < 10; i++ {
 			fmt.Println(<-c3)
 		}
 		quit <- 0
-	}()
+	})
 	fibonacci2(c3, quit)
 
 
@@ -0,0 +1,118 @@
+package basics
+
+import (
+	"errors"
+	"fmt"
+	"lets-go/trace"
+	"os"
+)
+
+/*
+defer w praktyce: jedno defer na początku funkcji mierzy czas i głębokość wywołania (pakiet trace),
+zmienia nazwane wyniki już po instrukcji return i zamienia panikę na zwykły błąd.
+*/
+func deferTracing() {
+	tr := trace.New()
+	var fib func(n int) int
+	fib = func(n int) int {
+		defer tr.Enter("fib", n)() // Enter wywołuje się teraz, zwrócona funkcja - przy wyjściu z fib
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}
+	fmt.Println("fib(4) =", fib(4))
+	tr.WriteTree(os.Stdout, false)
+	for _, s := range tr.Stats() {
+		fmt.Printf("%s: %d calls, max depth %d\n", s.Name, s.Calls, s.MaxDepth)
+	}
+
+	namedResults()
+	recovering(tr)
+
+	/*
+	Koszt defer mierzą benchmarki z trace/bench_test.go - uruchom je poleceniem go test -bench . ./trace
+	i porównaj BenchmarkDeferInLoop z BenchmarkLoopWithoutDefer. Zwykłe defer kosztuje niewiele więcej niż wywołanie
+	funkcji, ale defer w pętli alokuje rekord dla każdej iteracji i trzyma wszystkie do końca funkcji - w gorących
+	pętlach lepiej wydzielić ciało pętli do osobnej funkcji albo zwolnić zasób bez defer.
+	*/
+}
+
+/*
+Tak jak w split, wyniki x i y mają nazwy, więc są zwykłymi zmiennymi funkcji. return 21 najpierw przypisuje
+n = 21, potem wykonują się funkcje odroczone, a dopiero na końcu funkcja oddaje n wywołującemu - domknięcie
+w defer widzi więc i może zmienić wynik. Argumenty defer są jednak obliczane od razu: Println dostaje n == 0.
+*/
+func doubled() (n int) {
+	defer fmt.Println("n when defer was evaluated:", n)
+	defer func() { n *= 2 }()
+	return 21
+}
+
+// splitChecked to split, w którym defer dopisuje kontekst do każdego błędu bez powtarzania go przy każdym return.
+func splitChecked(sum int) (x, y int, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("split(%d): %w", sum, err)
+			x, y = 0, 0
+		}
+	}()
+	if sum < 0 {
+		return sum, 0, errors.New("negative sum")
+	}
+	x, y = split(sum)
+	return x, y, nil
+}
+
+func namedResults() {
+	fmt.Println("doubled:", doubled())
+	for _, sum := range []int{17, -1} {
+		x, y, err := splitChecked(sum)
+		fmt.Println("splitChecked:", x, y, err)
+	}
+}
+
+/*
+recover zatrzymuje panikę tylko wtedy, gdy jest wywołane bezpośrednio w funkcji odroczonej. Poza nią
+(albo w funkcji wywołanej przez funkcję odroczoną) zwraca nil. Razem z nazwanym wynikiem err pozwala
+zamienić panikę na błąd - tak działa crash.Guard, który uruchamia każdą lekcję.
+*/
+func safeDiv(a, b int) (q int, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("recovered: %v", v)
+		}
+	}()
+	return a / b, nil
+}
+
+func recovering(tr *trace.Tracer) {
+	fmt.Println("recover outside defer:", recover())
+	for _, b := range []int{4, 0} {
+		q, err := safeDiv(12, b)
+		fmt.Printf("safeDiv(12, %d) = %d, %v\n", b, q, err)
+	}
+
+	/*
+	Funkcja wyjścia z Tracer.Enter odzyskuje panikę, zapisuje ją w drzewie i panikuje ponownie, więc panika
+	przechodzi przez wszystkie śledzone poziomy aż do recover w outer.
+	*/
+	tr.Reset()
+	inner := func() {
+		defer tr.Enter("inner")()
+		var m map[string]int
+		m["x"] = 1 // zapis do mapy nil
+	}
+	outer := func() (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				err = fmt.Errorf("%v", v)
+			}
+		}()
+		defer tr.Enter("outer")()
+		inner()
+		return nil
+	}
+	fmt.Println("outer:", outer())
+	tr.WriteTree(os.Stdout, false)
+}
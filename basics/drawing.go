@@ -0,0 +1,306 @@
+package basics
+
+import (
+	"fmt"
+	"lets-go/geom"
+	"math"
+	"strings"
+)
+
+/*
+Zamiast abstrakcyjnych I i T budujemy mały system rysowania oparty na interfejsach.
+Shape opisuje figurę, a Drawable to figura, którą umiemy narysować na płótnie (Canvas).
+Drawable osadza (ang. embeds) interfejs Shape: jego zbiór metod to suma metod Shape i metody Draw,
+więc każda wartość Drawable jest też Shape i można ją przypisać do zmiennej typu Shape bez sprawdzania typu.
+*/
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+}
+
+type Drawable interface {
+	Shape
+	Draw(c Canvas)
+}
+
+/*
+Canvas to płótno. Figury nie wiedzą, czy rysują znakami w terminalu, czy w pliku SVG -
+wywołanie c.Line trafia do implementacji konkretnego płótna dopiero w czasie wykonania programu.
+*/
+type Canvas interface {
+	Line(a, b Coordinates)
+	Circle(center Coordinates, r float64)
+}
+
+/*
+Circle osadza Coordinates jako środek okręgu. Pola X i Y oraz metody abs i scale są promowane:
+c.X to skrót od c.Coordinates.X, a c.scale(2) przesuwa środek, bo scale ma odbiorcę wskaźnikowego,
+a zmienna c jest adresowalna.
+*/
+type Circle struct {
+	Coordinates
+	R float64
+}
+
+// Obliczenia zostawiamy pakietowi geom - nasze typy tylko tłumaczą się na jego figury.
+func (c Circle) toGeom() geom.Circle {
+	return geom.Circle{Center: c.vec(), R: c.R}
+}
+
+func (c Circle) Area() float64      { return c.toGeom().Area() }
+func (c Circle) Perimeter() float64 { return c.toGeom().Perimeter() }
+func (c Circle) Draw(cv Canvas)     { cv.Circle(c.Coordinates, c.R) }
+
+// Rect osadza Coordinates jako lewy dolny narożnik.
+type Rect struct {
+	Coordinates
+	W, H float64
+}
+
+func (r Rect) toGeom() geom.Rect {
+	return geom.Rect{Min: r.vec(), Max: geom.V(r.X+r.W, r.Y+r.H)}
+}
+
+func (r Rect) Area() float64      { return r.toGeom().Area() }
+func (r Rect) Perimeter() float64 { return r.toGeom().Perimeter() }
+
+func (r Rect) Vertices() []Coordinates {
+	return []Coordinates{r.Coordinates, {r.X + r.W, r.Y}, {r.X + r.W, r.Y + r.H}, {r.X, r.Y + r.H}}
+}
+
+func (r Rect) Draw(cv Canvas) { drawPolygon(cv, r.Vertices()) }
+
+type Triangle struct {
+	A, B, C Coordinates
+}
+
+func (t Triangle) toGeom() geom.Polygon {
+	return geom.Polygon{t.A.vec(), t.B.vec(), t.C.vec()}
+}
+
+func (t Triangle) Area() float64           { return t.toGeom().Area() }
+func (t Triangle) Perimeter() float64      { return t.toGeom().Perimeter() }
+func (t Triangle) Vertices() []Coordinates { return []Coordinates{t.A, t.B, t.C} }
+func (t Triangle) Draw(cv Canvas)          { drawPolygon(cv, t.Vertices()) }
+
+func drawPolygon(cv Canvas, pts []Coordinates) {
+	for i := range pts {
+		cv.Line(pts[i], pts[(i+1)%len(pts)])
+	}
+}
+
+/*
+Polygonal to interfejs opcjonalny: nie każda figura go ma (okrąg nie ma wierzchołków),
+więc sprawdzamy go asercją typu d.(Polygonal), tak jak pakiet io sprawdza, czy Writer ma też WriteString.
+*/
+type Polygonal interface {
+	Vertices() []Coordinates
+}
+
+/*
+Group to figura złożona z innych figur - sama też jest Drawable, więc grupy można zagnieżdżać.
+Metody mają odbiorcę wskaźnikowego i obsługują nil, tak jak (*T).m2: pusta grupa (*Group)(nil)
+ma pole 0 i niczego nie rysuje, zamiast panikować.
+*/
+type Group struct {
+	Name  string
+	Items []Drawable
+}
+
+func (g *Group) Area() float64 {
+	if g == nil {
+		return 0
+	}
+	var total float64
+	for _, d := range g.Items {
+		total += d.Area()
+	}
+	return total
+}
+
+func (g *Group) Perimeter() float64 {
+	if g == nil {
+		return 0
+	}
+	var total float64
+	for _, d := range g.Items {
+		total += d.Perimeter()
+	}
+	return total
+}
+
+func (g *Group) Draw(cv Canvas) {
+	if g == nil {
+		return
+	}
+	for _, d := range g.Items {
+		d.Draw(cv)
+	}
+}
+
+/*
+ASCIICanvas rysuje znakami. Oś Y rośnie w górę jak w matematyce, a wiersze tekstu idą w dół,
+dlatego przy zapisie punktu odwracamy numer wiersza.
+*/
+type ASCIICanvas struct {
+	w, h  int
+	cells [][]rune
+}
+
+func NewASCIICanvas(w, h int) *ASCIICanvas {
+	cells := make([][]rune, h)
+	for i := range cells {
+		cells[i] = []rune(strings.Repeat(" ", w))
+	}
+	return &ASCIICanvas{w, h, cells}
+}
+
+func (a *ASCIICanvas) plot(x, y float64, ch rune) {
+	col, row := int(math.Round(x)), a.h-1-int(math.Round(y))
+	if col >= 0 && col < a.w && row >= 0 && row < a.h {
+		a.cells[row][col] = ch
+	}
+}
+
+// Line stawia punkty co krok nie dłuższy niż jedna kratka (algorytm DDA).
+func (a *ASCIICanvas) Line(p, q Coordinates) {
+	ch := '*'
+	switch {
+	case p.Y == q.Y:
+		ch = '-'
+	case p.X == q.X:
+		ch = '|'
+	}
+	steps := int(math.Max(math.Abs(q.X-p.X), math.Abs(q.Y-p.Y)))
+	for i := 0; i <= steps; i++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(i) / float64(steps)
+		}
+		a.plot(p.X+(q.X-p.X)*t, p.Y+(q.Y-p.Y)*t, ch)
+	}
+}
+
+func (a *ASCIICanvas) Circle(c Coordinates, r float64) {
+	n := int(2*math.Pi*r) * 2
+	for i := range n {
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		a.plot(c.X+r*math.Cos(angle), c.Y+r*math.Sin(angle), 'o')
+	}
+}
+
+func (a *ASCIICanvas) String() string {
+	var sb strings.Builder
+	for _, row := range a.cells {
+		sb.WriteString(strings.TrimRight(string(row), " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// SVGCanvas zapisuje te same wywołania jako elementy SVG. Tu też odwracamy oś Y, bo w SVG rośnie ona w dół.
+type SVGCanvas struct {
+	w, h  float64
+	scale float64
+	body  strings.Builder
+}
+
+func NewSVGCanvas(w, h, scale float64) *SVGCanvas {
+	return &SVGCanvas{w: w, h: h, scale: scale}
+}
+
+func (s *SVGCanvas) point(p Coordinates) (float64, float64) {
+	return p.X * s.scale, (s.h - p.Y) * s.scale
+}
+
+func (s *SVGCanvas) Line(p, q Coordinates) {
+	x1, y1 := s.point(p)
+	x2, y2 := s.point(q)
+	fmt.Fprintf(&s.body, "  <line x1=\"%g\" y1=\"%g\" x2=\"%g\" y2=\"%g\" stroke=\"black\"/>\n", x1, y1, x2, y2)
+}
+
+func (s *SVGCanvas) Circle(c Coordinates, r float64) {
+	x, y := s.point(c)
+	fmt.Fprintf(&s.body, "  <circle cx=\"%g\" cy=\"%g\" r=\"%g\" fill=\"none\" stroke=\"black\"/>\n", x, y, r*s.scale)
+}
+
+func (s *SVGCanvas) String() string {
+	return fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%g\" height=\"%g\">\n%s</svg>\n",
+		s.w*s.scale, s.h*s.scale, s.body.String())
+}
+
+/*
+describe to serializator oparty na switchu typów. Kolejność przypadków ma znaczenie: wybierany jest pierwszy pasujący,
+dlatego konkretne typy stoją przed interfejsami, a Drawable przed Shape (każdy Drawable jest też Shape).
+Przypadek nil łapie tylko interfejs bez wartości - (*Group)(nil) ma typ, więc trafia do case *Group.
+*/
+func describe(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case *Group:
+		if v == nil {
+			return "group(nil)"
+		}
+		items := make([]string, len(v.Items))
+		for i, d := range v.Items {
+			items[i] = describe(d)
+		}
+		return fmt.Sprintf("group %q [%s]", v.Name, strings.Join(items, ", "))
+	case Circle:
+		return fmt.Sprintf("circle(x=%g, y=%g, r=%g)", v.X, v.Y, v.R)
+	case Rect:
+		return fmt.Sprintf("rect(x=%g, y=%g, w=%g, h=%g)", v.X, v.Y, v.W, v.H)
+	case Polygonal:
+		return fmt.Sprintf("polygon%v", v.Vertices())
+	case Drawable:
+		return fmt.Sprintf("drawable %T", v)
+	case Shape:
+		return fmt.Sprintf("shape %T (area %.2f)", v, v.Area())
+	case fmt.Stringer:
+		return "stringer " + v.String()
+	}
+	return fmt.Sprintf("unknown %T", v)
+}
+
+func drawing() {
+	c := Circle{Coordinates{5, 4}, 3}
+	c.scale(2) // promowana metoda Coordinates: środek przesuwa się na (10, 8)
+	fmt.Println("circle center:", c.Coordinates, "distance from origin:", c.abs())
+
+	scene := &Group{Name: "scena", Items: []Drawable{
+		c,
+		Rect{Coordinates{1, 1}, 6, 4},
+		Triangle{Coordinates{16, 1}, Coordinates{26, 1}, Coordinates{21, 9}},
+	}}
+	for _, d := range scene.Items {
+		var s Shape = d // Drawable zawiera Shape, więc konwersja jest niejawna
+		_, polygonal := d.(Polygonal)
+		fmt.Printf("%-16T area=%6.2f perimeter=%6.2f polygonal=%v\n", d, s.Area(), s.Perimeter(), polygonal)
+	}
+	fmt.Printf("scene area=%.2f\n", scene.Area())
+
+	ascii := NewASCIICanvas(28, 12)
+	scene.Draw(ascii)
+	fmt.Print(ascii)
+
+	svg := NewSVGCanvas(28, 12, 10)
+	scene.Draw(svg)
+	fmt.Print(svg)
+
+	/*
+	Interfejs ma dwie części: typ i wartość. Interfejs jest równy nil tylko wtedy, gdy obie są puste.
+	Wskaźnik nil zapakowany w interfejs ma typ *Group, więc d != nil, a wywołanie metody trafia do
+	(*Group).Area z odbiorcą nil - dlatego sprawdzamy nil w metodzie, a nie przed jej wywołaniem.
+	*/
+	var empty *Group
+	var d Drawable = empty
+	fmt.Println("d == nil:", d == nil, "area:", d.Area())
+	d.Draw(ascii)
+
+	// Grupa jest sama figurą, więc można ją zagnieździć w innej grupie.
+	nested := &Group{Name: "zewnętrzna", Items: []Drawable{scene, empty}}
+	for _, v := range []any{nested, nil, geom.Circle{R: 1}, ServerState(0), 42} {
+		fmt.Println(describe(v))
+	}
+}
@@ -0,0 +1,196 @@
+package basics
+
+import (
+	"errors"
+	"fmt"
+	"lets-go/methodset"
+	"os"
+	"reflect"
+)
+
+func TestEmbedding() {
+	fmt.Println("--embedding------------------------------------------------------------------------------------------")
+	multiLevel()
+	ambiguity()
+	decorators()
+	embeddedPointers()
+	methodSets()
+}
+
+/*
+Osadzanie działa na dowolną głębokość: labeled osadza container, który osadza base, więc l.num i l.describe()
+sięgają dwa poziomy w dół. Selektor wybiera najpłytsze pole lub metodę o danej nazwie - pole str w labeled
+przesłania (ang. shadows) container.str, a metoda labeled.describe przesłania base.describe.
+Przesłonięte elementy nie znikają, wystarczy podać pełną ścieżkę: l.container.str, l.container.base.describe().
+*/
+type labeled struct {
+	container
+	str string
+}
+
+func (l labeled) describe() string {
+	return fmt.Sprintf("labeled %q around %s", l.str, l.container.describe())
+}
+
+func multiLevel() {
+	l := labeled{container{base{7}, "inner"}, "outer"}
+	fmt.Println("num:", l.num, "=", l.container.base.num)
+	fmt.Println("str:", l.str, "vs", l.container.str)
+	fmt.Println("describe:", l.describe())
+	fmt.Println("base describe:", l.container.describe())
+}
+
+/*
+Jeśli dwa pola osadzone na tej samej głębokości mają pole lub metodę o tej samej nazwie, selektor jest niejednoznaczny.
+Kompilator nie zgłasza błędu przy deklaracji typu, tylko przy użyciu:
+
+	a.Speed  // ambiguous selector a.Speed
+	a.Move() // ambiguous selector a.Move
+
+Trzeba wtedy wskazać pole (a.car.Move()) albo zadeklarować metodę Move w amphibian, która przesłoni obie.
+Metody bez konfliktu, jak Honk, są promowane normalnie.
+*/
+type car struct {
+	Speed int
+}
+
+func (c car) Move() string { return fmt.Sprintf("drives at %d km/h", c.Speed) }
+func (c car) Honk() string { return "beep" }
+
+type boat struct {
+	Speed int
+}
+
+func (b boat) Move() string { return fmt.Sprintf("sails at %d knots", b.Speed) }
+
+type amphibian struct {
+	car
+	boat
+}
+
+func ambiguity() {
+	a := amphibian{car{60}, boat{8}}
+	fmt.Println("on land it", a.car.Move(), "- on water it", a.boat.Move(), "-", a.Honk())
+
+	// Bez metody Move amphibian nie spełnia interfejsu, mimo że oba osadzone typy go spełniają.
+	var x any = a
+	_, ok := x.(interface{ Move() string })
+	fmt.Println("amphibian has Move:", ok)
+}
+
+/*
+Osadzenie interfejsu w strukturze to wzorzec dekoratora: countingStore spełnia interfejs store, bo wszystkie
+metody osadzonego store są promowane, a sam nadpisuje tylko Get. Nie trzeba pisać metod, które tylko przekazują
+wywołanie dalej - to samo robi np. io.Reader osadzony w strukturach ze standardowej biblioteki.
+Jeśli osadzony interfejs jest nil, wywołanie promowanej metody panikuje z nil pointer dereference.
+*/
+type store interface {
+	Get(key string) (string, error)
+	Set(key, value string) error
+}
+
+var errNotFound = errors.New("not found")
+
+type mapStore map[string]string
+
+func (m mapStore) Get(key string) (string, error) {
+	v, ok := m[key]
+	if !ok {
+		return "", fmt.Errorf("%q: %w", key, errNotFound)
+	}
+	return v, nil
+}
+
+func (m mapStore) Set(key, value string) error {
+	m[key] = value
+	return nil
+}
+
+type countingStore struct {
+	store
+	Gets, Misses int
+}
+
+func (c *countingStore) Get(key string) (string, error) {
+	c.Gets++
+	v, err := c.store.Get(key)
+	if errors.Is(err, errNotFound) {
+		c.Misses++
+	}
+	return v, err
+}
+
+func decorators() {
+	cs := &countingStore{store: mapStore{}}
+	var s store = cs // *countingStore ma Get (własne) i Set (promowane z mapStore)
+	s.Set("lang", "go")
+	s.Get("lang")
+	_, err := s.Get("editor")
+	fmt.Printf("gets=%d misses=%d last error: %v\n", cs.Gets, cs.Misses, err)
+
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+	var empty countingStore
+	empty.Set("a", "b") // osadzony interfejs jest nil
+}
+
+/*
+Osadzony może być też wskaźnik. Kopia struktury kopiuje wtedy tylko wskaźnik, więc kopie dzielą ten sam silnik,
+a metody *engine (z odbiorcą wskaźnikowym) są w zbiorze metod zarówno truck, jak i *truck.
+Przy osadzeniu wartości (van) metody *engine ma tylko *van. Wskaźnik nil w osadzonym polu panikuje
+dopiero przy dostępie do pola lub metody, która czyta odbiorcę.
+*/
+type engine struct {
+	Power   int
+	running bool
+}
+
+func (e *engine) Start()         { e.running = true }
+func (e engine) Running() bool   { return e.running }
+func (e engine) HorsePower() int { return e.Power }
+
+type truck struct {
+	*engine
+	Name string
+}
+
+type van struct {
+	engine
+	Name string
+}
+
+func embeddedPointers() {
+	t1 := truck{&engine{Power: 400}, "t1"}
+	t2 := t1 // kopia dzieli *engine
+	t2.Name = "t2"
+	t2.Start()
+	fmt.Println(t1.Name, "running:", t1.Running(), "-", t2.Name, "running:", t2.Running())
+
+	v1 := van{engine{Power: 120}, "v1"}
+	v2 := v1 // kopia ma własny silnik
+	v2.Start()
+	fmt.Println(v1.Name, "running:", v1.Running(), "-", "v2 running:", v2.Running())
+
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+	var broken truck
+	fmt.Println(broken.Name == "", "- engine is nil:", broken.engine == nil)
+	fmt.Println(broken.Power)
+}
+
+/*
+Pakiet methodset odczytuje zbiory metod przez refleksję i pokazuje, skąd pochodzi każda metoda.
+Refleksja widzi tylko metody eksportowane, dlatego typy w tej lekcji mają metody z wielkiej litery.
+*/
+func methodSets() {
+	for _, t := range []reflect.Type{
+		reflect.TypeFor[truck](),
+		reflect.TypeFor[van](),
+		reflect.TypeFor[countingStore](),
+		reflect.TypeFor[amphibian](),
+	} {
+		methodset.Write(os.Stdout, t)
+	}
+}
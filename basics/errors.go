@@ -1,8 +1,14 @@
 package basics
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"lets-go/multierr"
+	"lets-go/retry"
+	"lets-go/validate"
+	"lets-go/xerrors"
+	"time"
 )
 
 func Errors() {
@@ -15,6 +21,21 @@ func Errors() {
 	} else {
 		fmt.Println("err doesn't match argError")
 	}
+
+	/*
+	f2 zwraca błąd z pakietu xerrors, który oprócz przyczyny (argError) niesie kod, pola i stos wywołań.
+	%+v wypisuje całe drzewo przyczyn, a xerrors.JSON tę samą informację w formacie JSON.
+	*/
+	fmt.Println("code:", xerrors.CodeOf(err), "fields:", xerrors.FieldsOf(err))
+	fmt.Printf("%+v", err)
+	if data, err := xerrors.JSON(err); err == nil {
+		fmt.Println(string(data))
+	}
+
+	teaTime()
+	teaWithRetry()
+	validation()
+	batch()
 }
 
 /*
@@ -38,17 +59,26 @@ var ErrPower = fmt.Errorf("can't boil water")
 
 func makeTea(arg int) error {
 	if arg == 2 {
-		return ErrOutOfTea
+		return xerrors.Wrap(ErrOutOfTea, xerrors.CodeNotFound, "making tea", "arg", arg)
 	} else if arg == 4 {
 
 		/*
 		Możemy zawijać błędy z błędami wyższego poziomu, aby dodać kontekst. 
 		Najprostszym sposobem na to jest użycie %w w fmt.Errorf. Zawinięte błędy tworzą logiczny łańcuch (A zawija B, który zawija C itd.), 
 		który można sprawdzić za pomocą funkcji takich jak errors.Is i errors.As.
+		xerrors.Wrap działa tak samo jak fmt.Errorf("making tea: %w", ErrPower), ale dodatkowo zapisuje kod błędu,
+		pola z kontekstem oraz stos wywołań.
 		*/
-		return fmt.Errorf("making tea: %w", ErrPower)
+		return xerrors.Wrap(ErrPower, xerrors.CodeUnavailable, "making tea", "arg", arg)
 	}
+	return nil
+}
 
+/*
+teaTime parzy kilka herbat. Wcześniej ta pętla znajdowała się wewnątrz makeTea i wywoływała ją rekurencyjnie,
+przez co makeTea(0) nigdy się nie kończyło.
+*/
+func teaTime() {
 	for i := range 5 {
 		if err := makeTea(i); err != nil {
 
@@ -69,7 +99,51 @@ func makeTea(arg int) error {
 
 		fmt.Println("Tea is ready!")
 	}
-	return nil
+}
+
+/*
+Brak prądu (ErrPower) jest błędem przejściowym - warto spróbować ponownie za chwilę.
+Brak herbaty (ErrOutOfTea) jest trwały - ponawianie nic nie da, trzeba iść do sklepu.
+Pakiet retry ponawia tylko błędy przejściowe, czekając coraz dłużej między próbami (ang. exponential backoff).
+FakeClock sprawia, że w lekcji nie czekamy naprawdę, a jedynie wypisujemy opóźnienia.
+*/
+func teaWithRetry() {
+	policy := retry.Policy{
+		Backoff:     retry.Exponential{Initial: 100 * time.Millisecond, Max: time.Second},
+		MaxAttempts: 5,
+		Classify:    retry.RetryOn(ErrPower),
+		Clock:       retry.NewFakeClock(time.Now()),
+		OnAttempt: func(a retry.Attempt) {
+			switch {
+			case a.Err == nil:
+				fmt.Printf("attempt %d succeeded\n", a.Number)
+			case a.Delay > 0:
+				fmt.Printf("attempt %d failed (%s): %v, next in %v\n", a.Number, a.Class, a.Err, a.Delay)
+			default:
+				fmt.Printf("attempt %d failed (%s): %v, giving up\n", a.Number, a.Class, a.Err)
+			}
+		},
+	}
+
+	outages := 2
+	err := policy.Do(context.Background(), func(ctx context.Context) error {
+		if outages > 0 {
+			outages--
+			return makeTea(4)
+		}
+		return makeTea(0)
+	})
+	fmt.Println("flaky power:", err)
+
+	err = policy.Do(context.Background(), func(ctx context.Context) error {
+		return makeTea(2)
+	})
+	fmt.Println("out of tea:", err, errors.Is(err, ErrOutOfTea))
+
+	err = policy.Do(context.Background(), func(ctx context.Context) error {
+		return makeTea(4)
+	})
+	fmt.Println("no power at all:", err, errors.Is(err, retry.ErrExhausted), errors.Is(err, ErrPower))
 }
 
 /*
@@ -90,8 +164,64 @@ func (e *argError) Error() string {
 func f2(arg int) (int, error) {
 	if arg == 42 {
 
-		// Return our custom error.
-		return -1, &argError{arg, "can't work with it"}
+		// Return our custom error. errors.As nadal go znajdzie, bo jest przyczyną błędu z xerrors.
+		return -1, xerrors.Wrap(&argError{arg, "can't work with it"}, xerrors.CodeInvalidArgument, "f2", "arg", arg)
 	}
 	return arg + 3, nil
 }
+
+
+/*
+Pakiet validate rozszerza pomysł z argError na całe struktury: zamiast jednego błędnego argumentu
+zbiera wszystkie naruszenia reguł z tagów `validate:"..."` w jeden błąd *validate.ValidationError.
+Tak jak wcześniej, konkretny typ błędu wyciągamy za pomocą errors.As.
+*/
+func validation() {
+	people := []Person{
+		{"Arthur Dent", 42},
+		{"", 9001},
+	}
+	for _, p := range people {
+		err := validate.Struct(p)
+		var ve *validate.ValidationError
+		if errors.As(err, &ve) {
+			for _, fe := range ve.Fields {
+				fmt.Printf("%s = %v: %s (rule %s)\n", fe.Path, fe.Value, fe.Message, fe.Rule)
+			}
+		} else {
+			fmt.Println(p, "is valid")
+		}
+	}
+
+	// ValidationError udostępnia poszczególne błędy przez Unwrap() []error, więc errors.As znajdzie też pierwszy *FieldError.
+	err := validate.Struct(User{UserType: UserType(7)})
+	fmt.Println(err)
+	var fe *validate.FieldError
+	if errors.As(err, &fe) {
+		fmt.Println("first field error:", fe.Path)
+	}
+}
+
+/*
+Przy przetwarzaniu wsadowym (ang. batch) chcemy poznać wszystkie błędy naraz, a nie tylko pierwszy.
+multierr.Map wywołuje funkcję dla każdego elementu i zbiera błędy razem z indeksami elementów.
+*/
+func batch() {
+	inputs := []int{1, 42, 7, 42, 13}
+
+	results, err := multierr.Map(inputs, f)
+	fmt.Println("f results:", results)
+	fmt.Println(err)
+
+	_, err2 := multierr.Map(inputs, f2)
+
+	// Wynik jest zwykłym błędem, więc można go łączyć z innymi przez errors.Join i sprawdzać przez errors.Is/As.
+	joined := errors.Join(err, err2, makeTea(2))
+	var ae *argError
+	fmt.Println("errors.As argError:", errors.As(joined, &ae), "errors.Is ErrOutOfTea:", errors.Is(joined, ErrOutOfTea))
+
+	var all multierr.Errors
+	all.Merge(joined)
+	fmt.Print("deduplicated:\n", multierr.Table(all.Dedup()))
+	fmt.Print("grouped:\n", all.Summary(ErrOutOfTea, ErrPower))
+}
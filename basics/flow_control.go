@@ -2,6 +2,7 @@ package basics
 
 import (
 	"fmt"
+	"lets-go/numeric"
 	"math"
 	"runtime"
 	"time"
@@ -51,27 +52,63 @@ func TestFlowControl() {
 	}
 
 	fmt.Println("Sqrt(77): ", Sqrt(77))
+	sqrtTrace(77)
 
 	checkOS()
 	checkTime()
 
 	testDefer()
+	deferTracing()
 }
 
-// Znajduje liczbę z, taką że z² jest możliwie najbliższej liczby x.
+/*
+Znajduje liczbę z, taką że z² jest możliwie najbliższej liczby x.
+Pętla metody Newtona została uogólniona w pakiecie numeric; tutaj zachowujemy parametry z lekcji:
+start od x/2, najwyżej 10 iteracji i tolerancję 1e-6. Kolejne przybliżenia wypisuje sqrtTrace.
+*/
 func Sqrt(x float64) float64 {
-	z := x / 2
-	for i := 0; i < 10; i++ {
-		newZ := z - (z*z-x)/(2*z)
-		if newZ == z || math.Abs(newZ-z) < 1e-6 {
-			fmt.Println("Iteration: ", i, " z: ", z)
-			return z
-		}
-		z = newZ
-		fmt.Println("Iteration: ", i, " z: ", z)
+	switch {
+	case x == 0:
+		return 0
+	case x < 0:
+		return math.NaN() // pierwiastek z liczby ujemnej: zob. numeric.Sqrt, który zwraca complex128
 	}
+	return sqrtNewton(x).Root
+}
+
+func sqrtNewton(x float64) numeric.Result {
+	res, _ := numeric.Newton(
+		func(z float64) float64 { return z*z - x },
+		func(z float64) float64 { return 2 * z },
+		x/2,
+		numeric.Options{Tol: 1e-6, MaxIter: 10},
+	)
+	return res
+}
 
-	return z
+func sqrtTrace(x float64) {
+	res := sqrtNewton(x)
+	for _, step := range res.Trace {
+		fmt.Println("Iteration: ", step.Iter, " z: ", step.X)
+	}
+	fmt.Printf("Sqrt(%g) = %v, math.Sqrt = %v, converged: %v\n", x, res.Root, math.Sqrt(x), res.Converged)
+
+	/*
+	Pakiet numeric zawiera też inne metody szukania miejsc zerowych. Porównajmy je dla równania cos(x) = x.
+	Wszystkie dochodzą do tego samego wyniku, ale w różnej liczbie kroków.
+	*/
+	f := func(x float64) float64 { return math.Cos(x) - x }
+	newton, _ := numeric.Newton(f, nil, 1, numeric.Options{})
+	secant, _ := numeric.Secant(f, 0, 1, numeric.Options{})
+	bisection, _ := numeric.Bisection(f, 0, 1, numeric.Options{})
+	brent, _ := numeric.Brent(f, 0, 1, numeric.Options{})
+	fmt.Println("cos(x) = x, Newton:", newton.Root, newton.Iterations, "iterations")
+	fmt.Println("cos(x) = x, secant:", secant.Root, secant.Iterations, "iterations")
+	fmt.Println("cos(x) = x, bisection:", bisection.Root, bisection.Iterations, "iterations")
+	fmt.Println("cos(x) = x, Brent:", brent.Root, brent.Iterations, "iterations")
+
+	cube, _ := numeric.NthRoot(-27, 3, numeric.Options{})
+	fmt.Println("NthRoot(-27, 3):", cube.Root, "Sqrt(-16):", numeric.Sqrt(-16))
 }
 
 func checkOS() {
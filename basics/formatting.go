@@ -0,0 +1,261 @@
+package basics
+
+import (
+	"fmt"
+	"io"
+	"lets-go/table"
+	"lets-go/text"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func TestFormatting() {
+	fmt.Println("--formatting-----------------------------------------------------------------------------------------")
+	formatting()
+}
+
+/*
+fmt.Stringer decyduje tylko o tym, jak wygląda %v i %s. Typ implementujący fmt.Formatter przejmuje całe formatowanie:
+metoda Format dostaje czasownik (ang. verb, np. 'v', 's', 'd') i fmt.State, z którego odczytuje flagi (+, -, #, spację, 0),
+szerokość i precyzję, a wynik pisze do State jak do io.Writer.
+
+Żeby w Format skorzystać z domyślnego formatowania struktury, nie można wywołać fmt na tej samej wartości -
+fmt znów wywołałby Format i program wpadłby w nieskończoną rekurencję. Dlatego konwertujemy wartość na typ
+o tej samej strukturze, ale bez metod (type plainPerson Person), i dopiero ten typ przekazujemy do fmt.
+*/
+type (
+	plainPerson      Person
+	plainVertex      Vertex
+	plainCoordinates Coordinates
+	plainUser        User
+)
+
+/*
+reformat formatuje x z tymi samymi flagami, szerokością i precyzją, z którymi wywołano Format.
+fmt.FormatString odtwarza dyrektywę, np. "%-8.3s", na podstawie State i czasownika.
+*/
+func reformat(f fmt.State, verb rune, x any) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), x)
+}
+
+/*
+pad wypisuje s dopełnione spacjami do szerokości z dyrektywy (z flagą - do lewej), tak jak fmt robi to z GoString.
+Szerokość liczymy w kolumnach terminala (text.Width), a nie w runach, więc emoji i znaki łączące nie psują wyrównania.
+*/
+func pad(f fmt.State, s string) {
+	w, ok := f.Width()
+	n := text.Width(s)
+	if !ok || n >= w {
+		io.WriteString(f, s)
+		return
+	}
+	fill := strings.Repeat(" ", w-n)
+	if f.Flag('-') {
+		io.WriteString(f, s+fill)
+	} else {
+		io.WriteString(f, fill+s)
+	}
+}
+
+/*
+Person:
+  - %v, %s i %q używają String() ("Arthur Dent (42 years)"), z szerokością i precyzją jak dla stringa,
+  - %+v pokazuje pola: {Name:Arthur Dent Age:42},
+  - %#v to GoString, czyli poprawny kod Go,
+  - pozostałe czasowniki trafiają do pól, jak w zwykłej strukturze.
+*/
+func (p Person) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		pad(f, p.GoString())
+	case verb == 'v' && f.Flag('+'):
+		reformat(f, verb, plainPerson(p))
+	case verb == 'v' || verb == 's' || verb == 'q':
+		reformat(f, verb, p.String())
+	default:
+		reformat(f, verb, plainPerson(p))
+	}
+}
+
+func (p Person) GoString() string {
+	return fmt.Sprintf("basics.Person{Name:%q, Age:%d}", p.Name, p.Age)
+}
+
+// LogValue sprawia, że slog zapisuje osobę jako grupę pól, np. person.name=... person.age=42, a nie jako jeden tekst.
+func (p Person) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("name", p.Name), slog.Int("age", p.Age))
+}
+
+/*
+Vertex i Coordinates zachowują domyślne %v ({1 2}), także z czasownikami liczbowymi stosowanymi do każdego pola (%x, %.2f).
+%s i %q dają zapis punktu "(1, 2)". Dla Coordinates precyzja w %s oznacza liczbę miejsc po przecinku: %.1s to "(3.0, 4.0)".
+*/
+func (v Vertex) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		pad(f, v.GoString())
+	case verb == 's' || verb == 'q':
+		reformat(f, verb, fmt.Sprintf("(%d, %d)", v.X, v.Y))
+	default:
+		reformat(f, verb, plainVertex(v))
+	}
+}
+
+func (v Vertex) GoString() string {
+	return fmt.Sprintf("basics.Vertex{X:%d, Y:%d}", v.X, v.Y)
+}
+
+func (v Vertex) LogValue() slog.Value {
+	return slog.GroupValue(slog.Int("x", v.X), slog.Int("y", v.Y))
+}
+
+func (v Coordinates) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		pad(f, v.GoString())
+	case verb == 's' || verb == 'q':
+		prec, ok := f.Precision()
+		if !ok {
+			prec = -1
+		}
+		s := "(" + strconv.FormatFloat(v.X, 'f', prec, 64) + ", " + strconv.FormatFloat(v.Y, 'f', prec, 64) + ")"
+		if verb == 'q' {
+			s = strconv.Quote(s)
+		}
+		pad(f, s)
+	default:
+		reformat(f, verb, plainCoordinates(v))
+	}
+}
+
+func (v Coordinates) GoString() string {
+	return fmt.Sprintf("basics.Coordinates{X:%#v, Y:%#v}", v.X, v.Y)
+}
+
+func (v Coordinates) LogValue() slog.Value {
+	return slog.GroupValue(slog.Float64("x", v.X), slog.Float64("y", v.Y))
+}
+
+/*
+ServerState: %v, %s i %q to nazwa stanu, %+v dodaje wartość liczbową ("connected(1)"), a %#v to GoString
+wygenerowany przez enumgen ("basics.StateConnected"). Czasowniki całkowitoliczbowe (%d, %x, %o, %b, %c, %U)
+formatują numer stanu - bez Format fmt wywołałby String() także dla %x i wypisał nazwę w kodzie szesnastkowym.
+Stan nie jest liczbą zmiennoprzecinkową, więc %f, %e i %g (jak każdy inny czasownik) dają błąd w stylu fmt:
+%!f(basics.ServerState=connected), a nie mylące %!f(int=01).
+*/
+func (s ServerState) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			pad(f, s.GoString())
+		case f.Flag('+'):
+			pad(f, s.String()+"("+strconv.Itoa(int(s))+")")
+		default:
+			reformat(f, verb, s.String())
+		}
+	case 's', 'q':
+		reformat(f, verb, s.String())
+	case 'd', 'x', 'X', 'o', 'O', 'b', 'c', 'U':
+		reformat(f, verb, int(s))
+	default:
+		fmt.Fprintf(f, "%%!%c(basics.ServerState=%s)", verb, s.String())
+	}
+}
+
+func (s ServerState) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+/*
+User: %s i %q dają krótki opis ("u-1 (admin, active)"), %#v to GoString z time.Date(...),
+a pozostałe czasowniki, w tym %v, formatują pola jak zwykła struktura.
+*/
+func (u User) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		pad(f, u.GoString())
+	case verb == 's' || verb == 'q':
+		status := "inactive"
+		if u.IsActive {
+			status = "active"
+		}
+		reformat(f, verb, fmt.Sprintf("%s (%v, %s)", u.UserID, u.UserType, status))
+	default:
+		reformat(f, verb, plainUser(u))
+	}
+}
+
+func (u User) GoString() string {
+	return fmt.Sprintf("basics.User{UserID:%q, IsActive:%t, LastLogin:%#v, UserType:%#v}",
+		u.UserID, u.IsActive, u.LastLogin, u.UserType)
+}
+
+func (u User) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", u.UserID),
+		slog.Bool("active", u.IsActive),
+		slog.Time("last_login", u.LastLogin),
+		slog.String("type", u.UserType.String()),
+	)
+}
+
+func formatting() {
+	user := User{"u-1", true, time.Date(2024, time.May, 1, 12, 30, 0, 0, time.UTC), UserAdmin}
+	values := []struct {
+		name string
+		v    any
+	}{
+		{"Person", Person{"Arthur Dent", 42}},
+		{"Vertex", Vertex{10, 255}},
+		{"Coordinates", Coordinates{3, 4.5}},
+		{"ServerState", StateConnected},
+		{"User", user},
+	}
+	verbs := []string{"%v", "%+v", "%#v", "%s", "%q", "%d", "%x", "%-14v|", "%14s|", "%.4s", "%.2f", "%T"}
+
+	/*
+	Macierz czasownik × typ. Długie komórki skracamy, żeby tabela mieściła się w terminalu;
+	pełny %#v dla User jest niżej. Kombinacje, których typ nie definiuje (fmt wypisuje wtedy %!czasownik(...),
+	np. %d dla pola Name osoby albo %.2f dla int), oznaczamy "-". Vertex i Coordinates stosują czasownik
+	do każdego pola, więc %x działa dla obu, a %d tylko dla Vertex.
+	*/
+	columns := []table.Column{{Name: "verb", Type: table.Text}}
+	for _, x := range values {
+		columns = append(columns, table.Column{Name: x.name, Type: table.Text})
+	}
+	matrix := table.New(columns...)
+	for _, verb := range verbs {
+		row := []any{verb}
+		for _, x := range values {
+			cell := fmt.Sprintf(verb, x.v)
+			if strings.Contains(cell, "%!") {
+				cell = "-"
+			}
+			row = append(row, text.TruncateWidth(cell, 24, "…"))
+		}
+		matrix.Append(row...)
+	}
+	matrix.WriteText(os.Stdout)
+	fmt.Printf("%#v\n", user)
+	fmt.Printf("%%.2f for ServerState: %.2f\n", StateConnected)
+
+	/*
+	slog wywołuje LogValue dla wartości implementujących slog.LogValuer, więc te same typy zapisują się jako
+	grupy pól w formacie tekstowym i JSON. ReplaceAttr usuwa czas wpisu, żeby wynik był powtarzalny.
+	*/
+	dropTime := func(groups []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey && len(groups) == 0 {
+			return slog.Attr{}
+		}
+		return a
+	}
+	opts := &slog.HandlerOptions{ReplaceAttr: dropTime}
+	for _, h := range []slog.Handler{slog.NewTextHandler(os.Stdout, opts), slog.NewJSONHandler(os.Stdout, opts)} {
+		slog.New(h).Info("login", "person", Person{"Arthur Dent", 42}, "at", Vertex{1, 2},
+			"state", StateConnected, "user", user)
+	}
+}
@@ -0,0 +1,47 @@
+package basics
+
+import (
+	"fmt"
+	"lets-go/text"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestServerStateFormat(t *testing.T) {
+	for _, tc := range []struct{ format, want string }{
+		{"%v", "connected"},
+		{"%+v", "connected(1)"},
+		{"%#v", "basics.StateConnected"},
+		{"%q", `"connected"`},
+		{"%d", "1"},
+		{"%03d", "001"},
+		{"%x", "1"},
+		{"%b", "1"},
+		{"%.2f", "%!f(basics.ServerState=connected)"},
+		{"%e", "%!e(basics.ServerState=connected)"},
+		{"%-12v|", "connected   |"},
+		{"%#24v|", "   basics.StateConnected|"},
+	} {
+		if got := fmt.Sprintf(tc.format, StateConnected); got != tc.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tc.format, got, tc.want)
+		}
+	}
+	if got, want := fmt.Sprintf("%#v", ServerState(9)), "basics.ServerState(9)"; got != want {
+		t.Errorf("%%#v of an unknown state = %q, want %q", got, want)
+	}
+}
+
+// TestPadWidth sprawdza, że pad liczy szerokość w kolumnach terminala, a nie w runach: "ś" zapisane jako s
+// ze znakiem łączącym U+0301 to dwie runy, ale jedna kolumna.
+func TestPadWidth(t *testing.T) {
+	p := Person{"Zos\u0301ka", 7}
+	gs := p.GoString()
+	if text.Width(gs) == utf8.RuneCountInString(gs) {
+		t.Fatalf("%q: width equals rune count, the test would not catch anything", gs)
+	}
+	want := strings.Repeat(" ", 40-text.Width(gs)) + gs + "|"
+	if got := fmt.Sprintf("%#40v|", p); got != want {
+		t.Errorf("Sprintf(%%#40v) = %q, want %q", got, want)
+	}
+}
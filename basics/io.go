@@ -2,15 +2,41 @@ package basics
 
 import (
 	"fmt"
+	"lets-go/calc"
+	"os"
 )
 
 func IO() {
 	fmt.Println("--Basics I/O-----------------------------------------------------------------------------------------")
-	var a, b int
 	/*
 	Metoda fmt.Scan() służy do wczytywania danych z wejścia standardowego.
-	Przyjmuje jako argumenty wskaźniki do zmiennych, do których mają zostać wczytane wartości.
+	Przyjmuje jako argumenty wskaźniki do zmiennych, do których mają zostać wczytane wartości,
+	i zwraca liczbę wczytanych wartości oraz błąd - np. gdy zamiast liczby wpiszemy tekst.
+
+		var a, b int
+		if _, err := fmt.Scan(&a, &b); err != nil { ... }
+
+	Zamiast dwóch liczb czytamy całe linie (bufio.Scanner w calc.REPL) i liczymy je kalkulatorem z pakietu calc:
+	"2 + 3", "r = 2", "pi * r^2", "sqrt(2)", "hypot(3, 4)". Błędy wskazują linię i kolumnę, np. "1:5: undefined variable x".
+	Pusta linia na końcu wejścia (Ctrl+D) kończy pracę.
 	*/
-	fmt.Scan(&a, &b)
-	fmt.Println(a + b)
-}
\ No newline at end of file
+	if err := NewCalculator().REPL(os.Stdin, os.Stdout); err != nil {
+		fmt.Println("IO:", err)
+	}
+}
+
+/*
+NewCalculator tworzy kalkulator, w którym sqrt to Sqrt z lekcji flow_control (metoda Newtona).
+Sqrt kończy iteracje przy tolerancji 1e-6, dlatego sqrt(16) daje 4.000000000000004, a nie dokładnie 4.
+*/
+func NewCalculator() *calc.Interpreter {
+	in := calc.New()
+	in.Register("sqrt", 1, func(args []calc.Value) (calc.Value, error) {
+		x := args[0].Float64()
+		if x < 0 {
+			return calc.Value{}, fmt.Errorf("square root of negative number %v", args[0])
+		}
+		return calc.Float(Sqrt(x)), nil
+	})
+	return in
+}
@@ -0,0 +1,56 @@
+package basics
+
+import (
+	"fmt"
+	"lets-go/inspect"
+	"os"
+	"time"
+	"unsafe"
+)
+
+/*
+Układ w pamięci (ang. memory layout) opisuje, ile bajtów zajmuje wartość i gdzie leżą jej pola.
+unsafe.Sizeof i unsafe.Alignof działają w czasie kompilacji dla znanego typu,
+a pakiet inspect liczy to samo przez refleksję dla dowolnej wartości i pokazuje całe drzewo.
+
+Wycinek to tylko nagłówek (wskaźnik, len, cap) - 24 bajty na 64-bitowej maszynie - niezależnie od liczby elementów.
+Dwa wycinki tej samej tablicy mają różne nagłówki, ale adresy wskazujące w tę samą tablicę.
+*/
+func memoryLayout() {
+	fmt.Println("unsafe.Sizeof(Vertex{}):", unsafe.Sizeof(Vertex{}), "Alignof:", unsafe.Alignof(Vertex{}))
+	fmt.Println("unsafe.Sizeof(User{}):", unsafe.Sizeof(User{}), "Offsetof(LastLogin):", unsafe.Offsetof(User{}.LastLogin))
+
+	inspect.Tree(os.Stdout, inspect.Inspect(Vertex{1, 2}))
+	inspect.Tree(os.Stdout, inspect.Inspect(User{UserID: "u-1", IsActive: true, LastLogin: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC), UserType: UserAdmin}))
+	inspect.Tree(os.Stdout, inspect.Inspect(container{base: base{num: 1}, str: "some name"}))
+
+	// Kolejność pól ma znaczenie: bool przed int64 wymusza 7 bajtów wypełnienia (ang. padding).
+	type loose struct {
+		A bool
+		B int64
+		C bool
+	}
+	type packed struct {
+		B int64
+		A bool
+		C bool
+	}
+	inspect.Tree(os.Stdout, inspect.Inspect(loose{}))
+	inspect.Tree(os.Stdout, inspect.Inspect(packed{}))
+
+	s := make([]int, 3, 5)
+	tail := s[1:]
+	inspect.Tree(os.Stdout, inspect.Inspect(s))
+	inspect.Tree(os.Stdout, inspect.Inspect(tail)) // adres większy o 8 bajtów, czyli o jeden int
+
+	// Łańcuch wskaźników z pointers(): **string -> *string -> string.
+	p2 := new(*string)
+	*p2 = new(string)
+	**p2 = "is this even possible?"
+	inspect.Tree(os.Stdout, inspect.Inspect(p2))
+
+	inspect.Tree(os.Stdout, inspect.Inspect(map[string]Vertex{"a": {1, 2}, "b": {3, 4}}))
+
+	fmt.Println("JSON:")
+	inspect.JSON(os.Stdout, inspect.Inspect(Vertex{1, 2}))
+}
@@ -2,6 +2,7 @@ package basics
 
 import (
 	"fmt"
+	"lets-go/geom"
 	"math"
 	"strconv"
 )
@@ -10,6 +11,9 @@ func TestMethodsAndInterfaces() {
 	fmt.Println("--methods and interfaces----------------------------------------------------------------------------")
 	methods()
 	interfaces()
+	geometry()
+	drawing()
+	components()
 }
 
 type Coordinates struct {
@@ -172,11 +176,47 @@ func interfaces() {
 }
 
 type Person struct {
-	Name string
-	Age  int
+	Name string `validate:"required"`
+	Age  int    `validate:"min=0,max=150"`
 }
 
 func (p Person) String() string {
 	return fmt.Sprintf("%v (%v years)", p.Name, p.Age)
 }
 
+
+// vec zamienia Coordinates na wektor z pakietu geom.
+func (v Coordinates) vec() geom.Vec2[float64] {
+	return geom.V(v.X, v.Y)
+}
+
+/*
+Pakiet geom definiuje interfejs Shape z metodami Area, Perimeter i Contains.
+Wycinek []geom.Shape może przechowywać figury różnych typów, a wywołanie metody na elemencie
+wybiera implementację konkretnego typu dopiero w czasie wykonania programu (ang. dynamic dispatch).
+*/
+func geometry() {
+	c := Coordinates{3, 4}
+	fmt.Println("abs:", c.abs(), "Len:", c.vec().Len())
+
+	shapes := []geom.Shape{
+		geom.Circle{Center: c.vec(), R: 1},
+		geom.Rect{Min: geom.V(0.0, 0.0), Max: geom.V(4.0, 3.0)},
+		geom.Polygon{geom.V(0.0, 0.0), geom.V(4.0, 0.0), geom.V(0.0, 3.0)},
+	}
+	for _, s := range shapes {
+		fmt.Printf("%-14T area=%6.2f perimeter=%6.2f contains(1,1)=%v\n",
+			s, s.Area(), s.Perimeter(), s.Contains(geom.V(1.0, 1.0)))
+	}
+
+	// Typy generyczne działają też dla liczb całkowitych, np. punktów jak w Vertex.
+	hull := geom.ConvexHull([]geom.Vec2[int]{
+		geom.V(0, 0), geom.V(2, 1), geom.V(4, 0), geom.V(4, 4), geom.V(1, 2), geom.V(0, 4),
+	})
+	fmt.Println("convex hull:", hull)
+
+	d1 := geom.Segment{A: geom.V(0.0, 0.0), B: geom.V(4.0, 4.0)}
+	d2 := geom.Segment{A: geom.V(0.0, 4.0), B: geom.V(4.0, 0.0)}
+	p, ok := d1.Intersect(d2)
+	fmt.Println("intersection:", p, ok)
+}
@@ -0,0 +1,428 @@
+package basics
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+/*
+Serializacja (ang. serialization) to zamiana wartości Go na ciąg bajtów w określonym formacie i z powrotem.
+Wszystkie trzy kodeki poniżej opierają się na tagach struktury User: json, xml oraz csv.
+
+Pole LastLogin typu time.Time implementuje encoding.TextMarshaler i zapisuje się w formacie RFC 3339
+(z częścią ułamkową sekund, jeśli jest niezerowa), np. "2024-05-01T12:30:00+02:00".
+Przy odczycie strefa czasowa jest zachowana jako przesunięcie, dlatego czasy porównujemy metodą Equal, a nie ==.
+*/
+func serialization() {
+	users := []User{
+		{UserID: "u-1", IsActive: true, LastLogin: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC), UserType: UserAdmin},
+		{UserID: "u-2, \"quoted\"", IsActive: false, UserType: UserGuest},
+	}
+
+	for _, name := range []string{"json", "xml", "csv"} {
+		var buf bytes.Buffer
+		if err := userCodecs[name].Encode(&buf, users); err != nil {
+			fmt.Println(name, "encode error:", err)
+			continue
+		}
+		fmt.Printf("--%s--\n%s\n", name, buf.String())
+	}
+
+	// Domyślnie encoding/json ignoruje pola, których nie zna. Tryb ścisły (DisallowUnknownFields)
+	// zgłasza błąd, co pozwala wychwycić literówki w nazwach pól.
+	input := `[{"userID":"u-3","isActive":true,"userType":"member","lastLogn":"2024-01-01T00:00:00Z"}]`
+	_, err := jsonCodec{}.Decode(strings.NewReader(input))
+	fmt.Println("lenient json:", err)
+	_, err = jsonCodec{strict: true}.Decode(strings.NewReader(input))
+	fmt.Println("strict json:", err)
+	_, err = xmlCodec{strict: true}.Decode(strings.NewReader(`<users><user id="u-5"><admin>true</admin></user></users>`))
+	fmt.Println("strict xml:", err)
+
+	_, err = jsonCodec{}.Decode(strings.NewReader(`[{"userID":"u-4","userType":"superuser"}]`))
+	fmt.Println("unknown user type:", err)
+
+	inspectTags(os.Stdout, User{})
+
+	/*
+	Najważniejsza własność kodeka to decode(encode(x)) == x. Tutaj sprawdzamy ją dla jednego przykładu,
+	a serialization_test.go - dla wielu losowych użytkowników, także z przecinkami, cudzysłowami i znakami nowej linii.
+	*/
+	var buf bytes.Buffer
+	userCodecs["csv"].Encode(&buf, users)
+	decoded, err := userCodecs["csv"].Decode(&buf)
+	fmt.Println("csv round trip:", err == nil && len(decoded) == len(users) && sameUser(decoded[1], users[1]), err)
+}
+
+// UserCodec koduje i dekoduje listę użytkowników w jednym formacie.
+type UserCodec interface {
+	Encode(w io.Writer, users []User) error
+	Decode(r io.Reader) ([]User, error)
+}
+
+var userCodecs = map[string]UserCodec{
+	"json": jsonCodec{strict: true},
+	"xml":  xmlCodec{strict: true},
+	"csv":  csvCodec{strict: true},
+}
+
+type jsonCodec struct {
+	strict bool
+}
+
+func (c jsonCodec) Encode(w io.Writer, users []User) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(users)
+}
+
+func (c jsonCodec) Decode(r io.Reader) ([]User, error) {
+	dec := json.NewDecoder(r)
+	if c.strict {
+		dec.DisallowUnknownFields()
+	}
+	var users []User
+	if err := dec.Decode(&users); err != nil {
+		return nil, fmt.Errorf("decoding json: %w", err)
+	}
+	if c.strict {
+		if _, err := dec.Token(); err != io.EOF {
+			return nil, errors.New("decoding json: unexpected data after users")
+		}
+	}
+	return users, nil
+}
+
+/*
+XML wymaga elementu głównego, dlatego listę użytkowników opakowujemy w <users>.
+Pole XMLName typu xml.Name ustala nazwę elementu.
+*/
+type xmlUsers struct {
+	XMLName xml.Name `xml:"users"`
+	Users   []User   `xml:"user"`
+}
+
+/*
+encoding/xml nie ma odpowiednika DisallowUnknownFields. Zamiast tego osadzamy User w strukturze,
+która zbiera nieznane elementy (tag ",any") i atrybuty (tag ",any,attr"), a potem sprawdzamy, czy coś do nich trafiło.
+*/
+type strictXMLUser struct {
+	User
+	UnknownElems []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+	UnknownAttrs []xml.Attr `xml:",any,attr"`
+}
+
+type strictXMLUsers struct {
+	XMLName xml.Name        `xml:"users"`
+	Users   []strictXMLUser `xml:"user"`
+}
+
+type xmlCodec struct {
+	strict bool
+}
+
+func (c xmlCodec) Encode(w io.Writer, users []User) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(xmlUsers{Users: users}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (c xmlCodec) Decode(r io.Reader) ([]User, error) {
+	if !c.strict {
+		var doc xmlUsers
+		if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decoding xml: %w", err)
+		}
+		return doc.Users, nil
+	}
+
+	var doc strictXMLUsers
+	dec := xml.NewDecoder(r)
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding xml: %w", err)
+	}
+	// Tak jak w JSON, po elemencie głównym mogą być tylko białe znaki i komentarze.
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding xml: %w", err)
+		}
+		switch tok := tok.(type) {
+		case xml.Comment:
+		case xml.CharData:
+			if len(bytes.TrimSpace(tok)) > 0 {
+				return nil, errors.New("decoding xml: unexpected data after users")
+			}
+		default:
+			return nil, errors.New("decoding xml: unexpected data after users")
+		}
+	}
+	users := make([]User, len(doc.Users))
+	for i, u := range doc.Users {
+		if len(u.UnknownElems) > 0 {
+			return nil, fmt.Errorf("decoding xml: user %d: unknown element <%s>", i, u.UnknownElems[0].XMLName.Local)
+		}
+		if len(u.UnknownAttrs) > 0 {
+			return nil, fmt.Errorf("decoding xml: user %d: unknown attribute %q", i, u.UnknownAttrs[0].Name.Local)
+		}
+		users[i] = u.User
+	}
+	return users, nil
+}
+
+/*
+Biblioteka standardowa nie ma kodera CSV dla struktur, więc piszemy własny przy pomocy refleksji (pakiet reflect).
+Kolumny odpowiadają polom z tagiem csv, a ich kolejność wynika z kolejności pól w strukturze.
+Wartości implementujące encoding.TextMarshaler (time.Time, UserType) są zapisywane przez MarshalText.
+*/
+type csvCodec struct {
+	strict bool
+}
+
+type csvColumn struct {
+	name  string
+	index int
+}
+
+func csvColumns(t reflect.Type) []csvColumn {
+	var cols []csvColumn
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("csv"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		cols = append(cols, csvColumn{name, i})
+	}
+	return cols
+}
+
+var textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+
+func (c csvCodec) Encode(w io.Writer, users []User) error {
+	cols := csvColumns(reflect.TypeFor[User]())
+	cw := csv.NewWriter(w)
+
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		header[i] = col.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(cols))
+	for _, u := range users {
+		v := reflect.ValueOf(u)
+		for i, col := range cols {
+			s, err := formatCSVField(v.Field(col.index))
+			if err != nil {
+				return fmt.Errorf("encoding csv: column %s: %w", col.name, err)
+			}
+			record[i] = s
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatCSVField(v reflect.Value) (string, error) {
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func (c csvCodec) Decode(r io.Reader) ([]User, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("decoding csv header: %w", err)
+	}
+
+	byName := make(map[string]int)
+	for _, col := range csvColumns(reflect.TypeFor[User]()) {
+		byName[col.name] = col.index
+	}
+	fields := make([]int, len(header))
+	for i, name := range header {
+		index, ok := byName[name]
+		if !ok {
+			if c.strict {
+				return nil, fmt.Errorf("decoding csv: unknown column %q", name)
+			}
+			index = -1
+		}
+		fields[i] = index
+	}
+
+	var users []User
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding csv: %w", err)
+		}
+
+		var u User
+		v := reflect.ValueOf(&u).Elem()
+		for i, s := range record {
+			if fields[i] < 0 {
+				continue
+			}
+			if err := parseCSVField(v.Field(fields[i]), s); err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("decoding csv: line %d, column %s: %w", line, header[i], err)
+			}
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func parseCSVField(v reflect.Value, s string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+/*
+inspectTags odczytuje tagi przez refleksję i pokazuje, jak każde pole zostanie zapisane w poszczególnych formatach.
+reflect.StructTag.Lookup odróżnia brak tagu od pustego tagu.
+*/
+func inspectTags(w io.Writer, v any) {
+	t := reflect.TypeOf(v)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FIELD\tGO TYPE\tJSON\tXML\tCSV")
+	for i := range t.NumField() {
+		f := t.Field(i)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", f.Name, f.Type, jsonEncoding(f), xmlEncoding(f), csvEncoding(f))
+	}
+	tw.Flush()
+}
+
+// Opis typu wartości w zakodowanej postaci, wspólny dla wszystkich formatów.
+func encodedAs(t reflect.Type) string {
+	if t.Implements(textMarshalerType) {
+		return "text"
+	}
+	return t.Kind().String()
+}
+
+func jsonEncoding(f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("json")
+	name, opts, _ := strings.Cut(tag, ",")
+	switch {
+	case name == "-" && opts == "":
+		return "(skipped)"
+	case !ok || name == "":
+		name = f.Name
+	}
+	desc := fmt.Sprintf("%q: %s", name, encodedAs(f.Type))
+	if opts != "" {
+		desc += " [" + opts + "]"
+	}
+	return desc
+}
+
+func xmlEncoding(f reflect.StructField) string {
+	tag, _ := f.Tag.Lookup("xml")
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return "(skipped)"
+	}
+	if name == "" {
+		name = f.Name
+	}
+	switch {
+	case strings.Contains(opts, "attr"):
+		return fmt.Sprintf("attr %s=%q", name, encodedAs(f.Type))
+	case strings.Contains(opts, "chardata"):
+		return "chardata"
+	default:
+		return fmt.Sprintf("<%s>%s</%s>", name, encodedAs(f.Type), name)
+	}
+}
+
+func csvEncoding(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("csv"), ",")
+	if name == "-" {
+		return "(skipped)"
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return fmt.Sprintf("column %s: %s", name, encodedAs(f.Type))
+}
+
+func sameUser(a, b User) bool {
+	return a.UserID == b.UserID && a.IsActive == b.IsActive &&
+		a.LastLogin.Equal(b.LastLogin) && a.UserType == b.UserType
+}
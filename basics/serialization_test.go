@@ -0,0 +1,106 @@
+package basics
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+)
+
+// randomUser losuje użytkownika, także z przecinkami, cudzysłowami, znakami nowej linii i znakami specjalnymi XML w identyfikatorze.
+func randomUser(rng *rand.Rand) User {
+	const alphabet = "abcXYZ019 ,;\"'<>&\nłóść🙋"
+	runes := []rune(alphabet)
+	id := make([]rune, rng.IntN(12))
+	for i := range id {
+		id[i] = runes[rng.IntN(len(runes))]
+	}
+
+	zone := time.FixedZone("", (rng.IntN(27)-12)*3600)
+	login := time.Unix(rng.Int64N(4102444800), rng.Int64N(1e9)).In(zone)
+	types := UserTypeValues()
+
+	return User{
+		UserID:    string(id),
+		IsActive:  rng.IntN(2) == 1,
+		LastLogin: login,
+		UserType:  types[rng.IntN(len(types))],
+	}
+}
+
+// TestRoundTrip sprawdza własność decode(encode(x)) == x dla losowych list użytkowników. Ziarno jest stałe.
+func TestRoundTrip(t *testing.T) {
+	for _, name := range []string{"json", "xml", "csv"} {
+		t.Run(name, func(t *testing.T) {
+			codec := userCodecs[name]
+			rng := rand.New(rand.NewPCG(2024, 5))
+			for round := range 50 {
+				users := make([]User, rng.IntN(10))
+				for i := range users {
+					users[i] = randomUser(rng)
+				}
+
+				var buf bytes.Buffer
+				if err := codec.Encode(&buf, users); err != nil {
+					t.Fatalf("round %d: encode: %v", round, err)
+				}
+				encoded := buf.String()
+				decoded, err := codec.Decode(&buf)
+				if err != nil {
+					t.Fatalf("round %d: decode: %v\n%s", round, err, encoded)
+				}
+				if len(decoded) != len(users) {
+					t.Fatalf("round %d: decoded %d users, want %d\n%s", round, len(decoded), len(users), encoded)
+				}
+				for i := range users {
+					if !sameUser(decoded[i], users[i]) {
+						t.Errorf("round %d, user %d: got %+v, want %+v", round, i, decoded[i], users[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestStrictDecode(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		codec UserCodec
+		input string
+		want  string // fragment błędu w trybie ścisłym
+	}{
+		{"json unknown field", jsonCodec{strict: true}, `[{"userID":"u-1","lastLogn":"2024-01-01T00:00:00Z"}]`, "unknown field"},
+		{"json trailing data", jsonCodec{strict: true}, `[{"userID":"u-1"}] [{"userID":"u-2"}]`, "after users"},
+		{"xml unknown element", xmlCodec{strict: true}, `<users><user id="u-1"><admin>true</admin></user></users>`, "unknown element <admin>"},
+		{"xml unknown attribute", xmlCodec{strict: true}, `<users><user id="u-1" role="admin"></user></users>`, `unknown attribute "role"`},
+		{"xml trailing data", xmlCodec{strict: true}, `<users><user id="u-1"></user></users><users></users>`, "after users"},
+		{"csv unknown column", csvCodec{strict: true}, "user_id,role\nu-1,admin\n", `unknown column "role"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.codec.Decode(strings.NewReader(tc.input))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("strict decode error = %v, want it to contain %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestLenientDecodeIgnoresUnknown(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		codec UserCodec
+		input string
+	}{
+		{"json", jsonCodec{}, `[{"userID":"u-1","lastLogn":"2024-01-01T00:00:00Z"}]`},
+		{"xml", xmlCodec{}, `<users><user id="u-1" role="admin"><admin>true</admin></user></users>`},
+		{"csv", csvCodec{}, "user_id,role\nu-1,admin\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			users, err := tc.codec.Decode(strings.NewReader(tc.input))
+			if err != nil || len(users) != 1 || users[0].UserID != "u-1" {
+				t.Errorf("Decode = %+v, %v; want one user u-1", users, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Code generated by enumgen; DO NOT EDIT.
+
+package basics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var _ServerStateNames = map[ServerState]string{
+	StateIdle:      "idle",
+	StateConnected: "connected",
+	StateError:     "error",
+	StateRetrying:  "retrying",
+}
+
+var _ServerStateByName = map[string]ServerState{
+	"idle":      StateIdle,
+	"connected": StateConnected,
+	"error":     StateError,
+	"retrying":  StateRetrying,
+}
+
+// String zwraca nazwę wartości lub ServerState(n) dla wartości spoza wyliczenia.
+func (i ServerState) String() string {
+	if name, ok := _ServerStateNames[i]; ok {
+		return name
+	}
+	return "ServerState(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// GoString zwraca nazwę stałej z nazwą pakietu lub basics.ServerState(n) dla wartości spoza wyliczenia.
+func (i ServerState) GoString() string {
+	switch i {
+	case StateIdle:
+		return "basics.StateIdle"
+	case StateConnected:
+		return "basics.StateConnected"
+	case StateError:
+		return "basics.StateError"
+	case StateRetrying:
+		return "basics.StateRetrying"
+	}
+	return "basics.ServerState(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// ParseServerState zamienia nazwę na wartość typu ServerState.
+func ParseServerState(s string) (ServerState, error) {
+	if v, ok := _ServerStateByName[s]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid ServerState: %q", s)
+}
+
+// ServerStateValues zwraca wszystkie wartości w kolejności deklaracji.
+func ServerStateValues() []ServerState {
+	return []ServerState{
+		StateIdle,
+		StateConnected,
+		StateError,
+		StateRetrying,
+	}
+}
+
+// IsValid sprawdza, czy wartość należy do wyliczenia.
+func (i ServerState) IsValid() bool {
+	_, ok := _ServerStateNames[i]
+	return ok
+}
+
+func (i ServerState) MarshalText() ([]byte, error) {
+	if !i.IsValid() {
+		return nil, fmt.Errorf("invalid ServerState: %d", int64(i))
+	}
+	return []byte(i.String()), nil
+}
+
+func (i *ServerState) UnmarshalText(text []byte) error {
+	v, err := ParseServerState(string(text))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+func (i ServerState) MarshalJSON() ([]byte, error) {
+	text, err := i.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (i *ServerState) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("ServerState should be a string, got %s", data)
+	}
+	return i.UnmarshalText([]byte(s))
+}
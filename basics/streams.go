@@ -0,0 +1,82 @@
+package basics
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"lets-go/textproc"
+	"os"
+	"regexp"
+	"strings"
+)
+
+func TestStreams() {
+	fmt.Println("--streams--------------------------------------------------------------------------------------------")
+	streams()
+}
+
+func streams() {
+	/*
+	fmt.Scan z lekcji IO czyta pojedyncze wartości. Przy dużych danych czytamy strumień: bufio.Scanner dzieli
+	io.Reader na rekordy funkcją podziału (bufio.SplitFunc) i trzyma w pamięci tylko bieżący kawałek wejścia.
+	SplitFunc dostaje nieprzeczytane bajty i zwraca, ile z nich zużyła (advance) i jaki rekord z nich wyszedł (token).
+	Zwrócenie 0, nil, nil oznacza "potrzebuję więcej danych".
+	*/
+	csv := "id,opis\n1,\"dwie\nlinie\"\n2,zwykły\n"
+	splits := []struct {
+		name  string
+		split bufio.SplitFunc
+	}{{"lines", textproc.ScanLines}, {"csv", textproc.ScanCSVRecords}}
+	for _, s := range splits {
+		sc := textproc.NewScanner(strings.NewReader(csv), s.split, 0)
+		var records []string
+		for sc.Scan() {
+			records = append(records, sc.Text())
+		}
+		fmt.Printf("%-5s %d rekordy: %q\n", s.name, len(records), records)
+	}
+	sc := textproc.NewScanner(strings.NewReader("AB12CD34EF"), textproc.ScanFixed(4), 0)
+	for sc.Scan() {
+		fmt.Printf("[%s] ", sc.Text())
+	}
+	fmt.Println()
+
+	/*
+	Etapy (textproc.Stage) łączymy w potok jak w powłoce: grep | sort | uniq -c | wc.
+	Każdy etap dostaje rekord i funkcję emit, którą przekazuje go dalej - albo nie, jeśli go odrzuca.
+	Dane spakowane gzipem są rozpoznawane po pierwszych dwóch bajtach (0x1f 0x8b), więc Run przyjmuje oba rodzaje wejścia.
+	*/
+	text := "kot\npies\nkot\nryba\nPies\nkot\n"
+	var packed bytes.Buffer
+	zw := gzip.NewWriter(&packed)
+	zw.Write([]byte(text))
+	zw.Close()
+	fmt.Printf("%d bajtów tekstu, %d bajtów gzip, początek: % x\n", len(text), packed.Len(), packed.Bytes()[:2])
+
+	run := func(title string, stages ...textproc.Stage) {
+		fmt.Println(title)
+		if err := textproc.Run(bytes.NewReader(packed.Bytes()), os.Stdout, textproc.ScanLines, stages...); err != nil {
+			fmt.Println("błąd:", err)
+		}
+	}
+	run("wc:", &textproc.Count{})
+	run("grep -i pies:", textproc.Grep(regexp.MustCompile(`(?i)pies`), false))
+	run("lower | sort | uniq -c:", textproc.Map(strings.ToLower), &textproc.Sort{}, &textproc.Uniq{Count: true})
+	run("dedup | head 2:", &textproc.Dedup{}, textproc.Head(2))
+
+	/*
+	Sort z limitem pamięci (MaxMemory) sortuje zewnętrznie: gdy bufor się zapełni, posortowana porcja trafia do pliku
+	tymczasowego, a na końcu porcje są scalane kopcem. Tutaj limit jest celowo mały, żeby zobaczyć kilka porcji.
+	*/
+	var numbers strings.Builder
+	for i := range 1000 {
+		fmt.Fprintf(&numbers, "%04d\n", (i*7919)%1000)
+	}
+	sorter := &textproc.Sort{MaxMemory: 4 << 10}
+	var out bytes.Buffer
+	if err := textproc.Run(strings.NewReader(numbers.String()), &out, textproc.ScanLines, sorter, textproc.Head(3)); err != nil {
+		fmt.Println("błąd:", err)
+	}
+	fmt.Printf("sort z limitem 4 KiB: %d porcji na dysku, pierwsze rekordy: %q\n", sorter.Runs(), strings.Fields(out.String()))
+}
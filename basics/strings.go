@@ -2,6 +2,8 @@ package basics
 
 import (
 	"fmt"
+	"lets-go/text"
+	"os"
 	"unicode/utf8"
 )
 
@@ -18,6 +20,52 @@ func TestStrings() {
 	*/
 	// Emoji example 🗿
 	emoji := "🙋🌍❗"
-	// len(emoji) // 11
-	utf8.RuneCountInString(emoji) // 3
+	fmt.Println("len(emoji):", len(emoji))                                       // 11
+	fmt.Println("utf8.RuneCountInString(emoji):", utf8.RuneCountInString(emoji)) // 3
+
+	/*
+	Runa to jednak nie zawsze to, co użytkownik uważa za jeden znak. Emoji 🙋🏽‍♀️ składa się z pięciu run:
+	osoby, modyfikatora koloru skóry, łącznika ZWJ (U+200D), symbolu kobiety i selektora wariantu U+FE0F.
+	Taki znak widziany przez użytkownika to grafem (ang. grapheme cluster); dzieli je pakiet text.
+	*/
+	for _, s := range []string{emoji, "🙋🏽‍♀️", "🇵🇱", "Zażółć", text.NFD("Zażółć"), "한국어"} {
+		fmt.Printf("%-14q bajty=%-2d runy=%-2d grafemy=%d szerokość=%d\n",
+			s, len(s), utf8.RuneCountInString(s), text.GraphemeCount(s), text.Width(s))
+	}
+
+	/*
+	Szerokość w kolumnach terminala różni się od liczby run: znaki CJK i emoji zajmują dwie kolumny,
+	a znaki łączące zero. text.PadRight wyrównuje kolumny tam, gdzie fmt z %-10s by się rozjechał.
+	*/
+	for _, word := range []string{"Wartość", "漢字", "🙋🏽‍♀️ hej"} {
+		fmt.Printf("|%s|%-10s|\n", text.PadRight(word, 10), word)
+	}
+
+	/*
+	Skracanie i odwracanie po bajtach lub runach może rozciąć znak: s[:1] z "żółw" to pół litery ż,
+	a odwrócenie run przenosi znaki łączące do innej litery. Funkcje z pakietu text tną tylko na granicach grafemów.
+	*/
+	zolw := text.NFD("żółw")
+	fmt.Printf("s[:1]=%q TruncateBytes=%q TruncateRunes=%q TruncateGraphemes=%q\n",
+		"żółw"[:1], text.TruncateBytes("żółw", 3), text.TruncateRunes(zolw, 3), text.TruncateGraphemes(zolw, 2))
+	fmt.Println("TruncateWidth:", text.TruncateWidth("Zażółć gęślą jaźń", 10, "…"))
+	fmt.Println("Reverse:", text.Reverse(zolw), "ReverseRunes:", text.ReverseRunes(zolw))
+
+	/*
+	Normalizacja: "ó" może być jedną runą (NFC) albo literą o i znakiem łączącym (NFD).
+	Wyglądają tak samo, ale == zwraca false, dopóki nie sprowadzimy obu do tej samej postaci.
+	*/
+	nfc, nfd := "Wartość", text.NFD("Wartość")
+	fmt.Println("NFC == NFD:", nfc == nfd, "bajty:", len(nfc), len(nfd), "po NFC:", text.NFC(nfd) == nfc)
+	fmt.Println("EqualFold:", text.EqualFold("ŻÓŁW", text.NFD("żółw")), "Fold:", text.Fold("ŁÓDŹ"))
+	fmt.Println("StripDiacritics:", text.StripDiacritics("Wartość"), text.StripDiacritics("Zażółć gęślą jaźń"))
+
+	/*
+	UTF-8 zapisuje runę na 1-4 bajtach. Pierwszy bajt zaczyna się od nagłówka, który mówi ile bajtów ma sekwencja
+	(0 dla ASCII, 110 dla dwóch, 1110 dla trzech, 11110 dla czterech), a każdy kolejny bajt od 10.
+	Bajt, który nie pasuje do tego schematu, jest niepoprawny - for range zwraca wtedy utf8.RuneError (U+FFFD).
+	Więcej: go run ./cmd/utf8explore -conv 'dowolny tekst'
+	*/
+	text.WriteTable(os.Stdout, "Wartość 🗿")
+	text.WriteTable(os.Stdout, "ą\xff\xc4")
 }
\ No newline at end of file
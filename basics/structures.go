@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"strings"
 	"math"
+	"time"
+	"lets-go/calculus"
+	"lets-go/slicevis"
+	"os"
 )
 
 func TestStructures() {
@@ -17,6 +21,8 @@ func TestStructures() {
 	functionAsValue()
 	enums()
 	embedding()
+	serialization()
+	memoryLayout()
 }
 
 /*
@@ -78,12 +84,25 @@ Tagi są zwykle używane do przechowywania informacji o tym jak pole powinno by
 */
 type User struct {
                         // this column has the struct tags
-    UserID    string    `json:"userID"`
-    IsActive  bool      `json:"isActive"`
-    LastLogin time.Time `json:"lastLogin"`
-    UserType  int       `json:"userType"`
+    UserID    string    `json:"userID" xml:"id,attr" csv:"user_id" validate:"required"`
+    IsActive  bool      `json:"isActive" xml:"active" csv:"is_active"`
+    LastLogin time.Time `json:"lastLogin" xml:"lastLogin" csv:"last_login"`
+    UserType  UserType  `json:"userType" xml:"type" csv:"user_type" validate:"oneof=guest member admin"`
 }
 
+/*
+UserType jest kolejnym typem wyliczeniowym. Dzięki wygenerowanym metodom MarshalText/UnmarshalText
+we wszystkich formatach (JSON, XML, CSV) zapisywany jest jako nazwa, np. "admin", a nie jako liczba.
+*/
+type UserType int
+
+//go:generate go run lets-go/cmd/enumgen -type=UserType
+const (
+    UserGuest UserType = iota // guest
+    UserMember                // member
+    UserAdmin                 // admin
+)
+
 func structs() {
 	v := Vertex{1, 2}
 	fmt.Println("Nowy strucy Vertex: ", v)
@@ -105,7 +124,12 @@ func structs() {
 		v3 = Vertex{}      // X:0 oraz Y:0
 		vp  = &Vertex{1, 2} // posiada typ *Vertex
 	)
+	/*
+	 Domyślnie fmt wypisuje wskaźnik na strukturę jako &{1 2}. Vertex implementuje jednak fmt.Formatter (lekcja formatting),
+	 a zbiór metod *Vertex zawiera metody Vertex, więc dla vp też wywoływane jest Format i znak & znika - typ sprawdzamy przez %T.
+	*/
 	fmt.Println("Struktura literalna: ", v1, v2, v3, vp)
+	fmt.Printf("vp ma typ %T\n", vp)
 }
 
 /*
@@ -166,6 +190,24 @@ func slices() {
 	fmt.Println("Zaktualizowane wycinki a i b: ", a, b)
 	fmt.Println("Zaktualizowane tablica names: ", names)
 
+	/*
+	 slicevis rysuje tablicę names i okna wycinków a i b. append na a nie przekracza pojemności,
+	 więc zapisuje w tej samej tablicy i nadpisuje "George", który jest widoczny przez b i names.
+	 Dopiero gdy pojemność się skończy, append tworzy nową tablicę, a a przestaje dzielić pamięć z b.
+	*/
+	tr := slicevis.NewTracker[string]()
+	tr.Track("names", names[:])
+	tr.Track("a", a)
+	tr.Track("b", b)
+	tr.Draw(os.Stdout)
+	a = tr.Append("a", a, "Yoko")
+	a = tr.Append("a", a, "Linda", "Brian")
+	for _, e := range tr.Events() {
+		fmt.Println(e)
+	}
+	tr.Draw(os.Stdout)
+	fmt.Println("a i b dzielą tablicę:", slicevis.SameArray(a, b), "names:", names)
+
 	/*
 	 Wycinki literalne (ang. slice literals)
 	 Wycinek literalny wygląda jak tablica literalna bez podanej długości.
@@ -248,6 +290,7 @@ func slices() {
 	for i := 0; i < len(board); i++ {
 		fmt.Printf("%s\n", strings.Join(board[i], " "))
 	}
+	// Pełna gra na planszy N×N, z wykrywaniem wygranej i komputerowym przeciwnikiem, znajduje się w pakiecie tictactoe (go run ./cmd/tictactoe).
 
 	/*
 	Aby dodać nowy element do wycinka, Go posiada wbudowaną funkcję append
@@ -272,6 +315,19 @@ func slices() {
 	s5 = append(s5, 2, 3, 4)
 	printSlice(s5)
 
+	// Każda realokacja to nowa tablica i kopia elementów; dla małych wycinków pojemność rośnie mniej więcej dwukrotnie.
+	grow := slicevis.NewTracker[int]()
+	var s6 []int
+	for i := range 10 {
+		s6 = grow.Append("s6", s6, i)
+	}
+	for _, e := range grow.Events() {
+		if e.Realloc {
+			fmt.Println(e)
+		}
+	}
+	grow.Draw(os.Stdout)
+
 	/*
 	Mozemy kopiować wycinek za pomocą wbudowanej funkcji copy
 	Funkcja kopiowania została zbudowana w taki sposób, że kopiuje tylko dostępne elementy do dostępnych miejsc. 
@@ -344,6 +400,19 @@ func functionAsValue() {
 	fmt.Println(compute(hypot))
 	fmt.Println(compute(math.Pow))
 
+	/*
+	Pakiet calculus przyjmuje funkcje jako wartości, tak jak compute.
+	Całkujemy math.Sin na [0, π] (dokładny wynik to 2), liczymy pochodną hypot po x w punkcie 3
+	i rozwiązujemy równanie y' = hypot(t, y) - ta sama sygnatura co argument compute.
+	*/
+	integral, _ := calculus.Simpson(math.Sin, 0, math.Pi, 40)
+	fmt.Printf("∫sin = %.10f (±%.1e)\n", integral.Value, integral.Err)
+	d := calculus.Central(func(x float64) float64 { return hypot(x, 4) }, 3, 0)
+	fmt.Printf("d/dx hypot(x, 4) at 3 = %.6f (±%.1e)\n", d.Value, d.Err)
+	sol := calculus.RK4(hypot, 0, 1, 1, 20)
+	fmt.Printf("y' = hypot(t, y), y(0) = 1: y(1) ≈ %.6f (±%.1e)\n", sol.Final(), sol.Err)
+	calculus.Plot(os.Stdout, math.Sin, 0, 2*math.Pi, 40, 8)
+
 	/*
 	Domknięcia funkcji (ang. function closures)
 	Funkcje w Go mogą być domknięciami. Domknięcie to funkcjia będąca wartością która odnosi się do zmiennych znajdujących się poza jej ciałem.
@@ -387,31 +456,32 @@ func enums() {
 		
     ns2 := transition(ns)
     fmt.Println(ns2)
+
+	// Wygenerowany kod pozwala też zamienić nazwę z powrotem na wartość.
+	parsed, err := ParseServerState("retrying")
+	fmt.Println(parsed, err, parsed.IsValid(), ServerStateValues())
+	fmt.Println(ServerState(7), ServerState(7).IsValid())
 }
 
 type ServerState int
 
+/*
+Nazwy wartości podajemy w komentarzu na końcu linii. Na ich podstawie go generate (narzędzie cmd/enumgen)
+tworzy plik serverstate_enum.go z metodami String(), IsValid(), funkcjami ParseServerState() i ServerStateValues()
+oraz obsługą kodowania do tekstu i JSON. Dodanie stałej bez nazwy kończy generowanie błędem.
+*/
+//go:generate go run lets-go/cmd/enumgen -type=ServerState
 const (
-    StateIdle ServerState = iota
-    StateConnected
-    StateError
-    StateRetrying
+    StateIdle ServerState = iota // idle
+    StateConnected               // connected
+    StateError                   // error
+    StateRetrying                // retrying
 )
 
 /*
 Implementując interfejs fmt.Stringer, wartości ServerState mogą być drukowane lub konwertowane na ciągi znaków.
+Metoda String() jest generowana, a dla nieznanych wartości zwraca np. "ServerState(7)" zamiast pustego ciągu.
 */
-var stateName = map[ServerState]string{
-    StateIdle:      "idle",
-    StateConnected: "connected",
-    StateError:     "error",
-    StateRetrying:  "retrying",
-}
-
-	
-func (ss ServerState) String() string {
-    return stateName[ss]
-}
 
 func transition(s ServerState) ServerState {
     switch s {
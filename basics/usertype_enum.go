@@ -0,0 +1,97 @@
+// Code generated by enumgen; DO NOT EDIT.
+
+package basics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var _UserTypeNames = map[UserType]string{
+	UserGuest:  "guest",
+	UserMember: "member",
+	UserAdmin:  "admin",
+}
+
+var _UserTypeByName = map[string]UserType{
+	"guest":  UserGuest,
+	"member": UserMember,
+	"admin":  UserAdmin,
+}
+
+// String zwraca nazwę wartości lub UserType(n) dla wartości spoza wyliczenia.
+func (i UserType) String() string {
+	if name, ok := _UserTypeNames[i]; ok {
+		return name
+	}
+	return "UserType(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// GoString zwraca nazwę stałej z nazwą pakietu lub basics.UserType(n) dla wartości spoza wyliczenia.
+func (i UserType) GoString() string {
+	switch i {
+	case UserGuest:
+		return "basics.UserGuest"
+	case UserMember:
+		return "basics.UserMember"
+	case UserAdmin:
+		return "basics.UserAdmin"
+	}
+	return "basics.UserType(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// ParseUserType zamienia nazwę na wartość typu UserType.
+func ParseUserType(s string) (UserType, error) {
+	if v, ok := _UserTypeByName[s]; ok {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid UserType: %q", s)
+}
+
+// UserTypeValues zwraca wszystkie wartości w kolejności deklaracji.
+func UserTypeValues() []UserType {
+	return []UserType{
+		UserGuest,
+		UserMember,
+		UserAdmin,
+	}
+}
+
+// IsValid sprawdza, czy wartość należy do wyliczenia.
+func (i UserType) IsValid() bool {
+	_, ok := _UserTypeNames[i]
+	return ok
+}
+
+func (i UserType) MarshalText() ([]byte, error) {
+	if !i.IsValid() {
+		return nil, fmt.Errorf("invalid UserType: %d", int64(i))
+	}
+	return []byte(i.String()), nil
+}
+
+func (i *UserType) UnmarshalText(text []byte) error {
+	v, err := ParseUserType(string(text))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+func (i UserType) MarshalJSON() ([]byte, error) {
+	text, err := i.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+func (i *UserType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("UserType should be a string, got %s", data)
+	}
+	return i.UnmarshalText([]byte(s))
+}
@@ -1,6 +1,11 @@
 package basics
 
-import "fmt"
+import (
+	"fmt"
+	"lets-go/bigcalc"
+	"lets-go/inspect"
+	"os"
+)
 
 /* 
  Instrukcja var deklaruje listę zmiennych; tak jak w liście argumentów funkcji typ podajemy na samym końcu.
@@ -86,10 +91,26 @@ func TestVariables() {
 	fmt.Println("needFloat Small: ", needFloat(Small))
 	fmt.Println("needFloat Big: ", needFloat(Big))
 
+	/* 
+	 Stała Big jest dokładna tylko dopóki nie ma typu. needFloat(Big) zamienia ją na float64, który ma 53 bity mantysy,
+	 więc 12676506002282295 staje się 12676506002282296, a mnożenie przez 0.1 dodaje kolejny błąd zaokrąglenia.
+	 Jako int64 Big się mieści, ale Big * 1000 już nie - przepełnienie "zawija" wynik do liczby ujemnej.
+	 bigcalc liczy to samo wyrażenie w int64, float64 i typach z math/big, a big.Rat daje dokładny wzorzec.
+	*/
+	for _, expr := range []string{"12676506002282295 * 0.1", "12676506002282295 * 1000"} {
+		rep, err := bigcalc.Compare(expr, 128)
+		if err != nil {
+			fmt.Println("bigcalc:", err)
+			continue
+		}
+		rep.Write(os.Stdout)
+	}
+
 }
 
 func printValueAndType(value any) {
-	fmt.Printf("Typ: %T Wartość: %v\n", value, value)
+	n := inspect.Inspect(value)
+	fmt.Printf("Typ: %T Rodzaj: %s Rozmiar: %d B Wartość: %v\n", value, n.Kind, n.Size, value)
 }
 
 
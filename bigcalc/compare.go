@@ -0,0 +1,157 @@
+package bigcalc
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+)
+
+// Status opisuje, jak wynik w danym trybie ma się do dokładnego wyniku z big.Rat.
+type Status int
+
+const (
+	Exact   Status = iota // identyczny z wzorcem
+	Rounded               // różni się, bo reprezentacja zaokrągla lub obcina
+	Wrapped               // int64 przepełnił się i zawinął
+	Failed                // tryb nie potrafi policzyć wyrażenia
+	Unknown               // brak wzorca do porównania (np. niecałkowity wykładnik)
+)
+
+func (s Status) String() string {
+	switch s {
+	case Exact:
+		return "exact"
+	case Rounded:
+		return "ROUNDED"
+	case Wrapped:
+		return "OVERFLOW"
+	case Failed:
+		return "error"
+	case Unknown:
+		return "n/a"
+	}
+	return "?"
+}
+
+// Row to wynik jednego trybu w porównaniu.
+type Row struct {
+	Mode   Mode
+	Value  *Value
+	Err    error
+	Status Status
+}
+
+// Report zbiera wyniki wszystkich trybów dla jednego wyrażenia.
+type Report struct {
+	Expr  string
+	Tree  string
+	Prec  uint
+	Exact *big.Rat
+	Rows  []Row
+}
+
+/*
+Compare liczy wyrażenie we wszystkich trybach i porównuje każdy wynik z dokładnym wynikiem big.Rat.
+Błąd zwracany jest tylko dla błędów składni - błędy obliczeń (np. dzielenie przez zero) trafiają do wierszy.
+*/
+func Compare(src string, prec uint) (*Report, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	if prec == 0 {
+		prec = DefaultPrec
+	}
+	rep := &Report{Expr: src, Tree: n.String(), Prec: prec}
+	for _, m := range Modes {
+		v, err := eval(n, m, prec)
+		rep.Rows = append(rep.Rows, Row{Mode: m, Value: v, Err: err})
+		if m == BigRat && err == nil {
+			rep.Exact = v.Rat
+		}
+	}
+	for i := range rep.Rows {
+		rep.Rows[i].Status = rep.status(rep.Rows[i])
+	}
+	return rep, nil
+}
+
+func (rep *Report) status(row Row) Status {
+	switch {
+	case row.Err != nil:
+		return Failed
+	case row.Value.Wrapped:
+		return Wrapped
+	case rep.Exact == nil:
+		return Unknown
+	}
+	if got := row.Value.Exact(); got == nil || got.Cmp(rep.Exact) != 0 {
+		return Rounded
+	}
+	return Exact
+}
+
+// Lossy mówi, czy którykolwiek tryb stracił informację względem wzorca.
+func (rep *Report) Lossy() bool {
+	for _, row := range rep.Rows {
+		if row.Status == Rounded || row.Status == Wrapped {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Write wypisuje wyniki w tabeli. Wiersze, które tracą informację, są oznaczone "!!",
+a dla zaokrąglonych wyników pokazujemy też różnicę względem wzorca.
+*/
+func (rep *Report) Write(w io.Writer) error {
+	fmt.Fprintf(w, "%s  =>  %s\n", rep.Expr, rep.Tree)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tMODE\tVALUE\tSTATUS\tNOTES")
+	for _, row := range rep.Rows {
+		mark := ""
+		if row.Status == Rounded || row.Status == Wrapped {
+			mark = "!!"
+		}
+		mode := row.Mode.String()
+		if row.Mode == BigFloat {
+			mode = fmt.Sprintf("big.Float/%d", rep.Prec)
+		}
+		value, notes := "-", []string(nil)
+		if row.Err != nil {
+			notes = append(notes, row.Err.Error())
+		} else {
+			value = abbreviate(row.Value.String())
+			notes = append(notes, row.Value.Notes...)
+			if row.Status == Rounded {
+				notes = append(notes, "diff "+rep.diff(row.Value))
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", mark, mode, value, row.Status, strings.Join(notes, "; "))
+	}
+	if rep.Exact != nil && !rep.Exact.IsInt() {
+		fmt.Fprintf(tw, "\t\t≈ %s\t\t\n", rep.Exact.FloatString(30))
+	}
+	return tw.Flush()
+}
+
+func (rep *Report) diff(v *Value) string {
+	got := v.Exact()
+	if got == nil {
+		return "∞"
+	}
+	d := new(big.Rat).Sub(got, rep.Exact)
+	return new(big.Float).SetRat(d).Text('g', 3)
+}
+
+// abbreviate skraca bardzo długie liczby (np. 10^400) do początku i końca, żeby tabela była czytelna.
+func abbreviate(s string) string {
+	const max = 48
+	if len(s) <= max {
+		return s
+	}
+	return fmt.Sprintf("%s…%s (%d chars)", s[:24], s[len(s)-12:], len(s))
+}
@@ -0,0 +1,580 @@
+package bigcalc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Mode określa reprezentację liczb, w której liczone jest wyrażenie.
+type Mode int
+
+const (
+	Int64 Mode = iota
+	Float64
+	BigInt
+	BigFloat
+	BigRat
+)
+
+var modeNames = [...]string{"int64", "float64", "big.Int", "big.Float", "big.Rat"}
+
+func (m Mode) String() string {
+	if m < 0 || int(m) >= len(modeNames) {
+		return "Mode(" + strconv.Itoa(int(m)) + ")"
+	}
+	return modeNames[m]
+}
+
+// Modes to wszystkie tryby w kolejności, w jakiej pokazuje je Compare.
+var Modes = []Mode{Int64, Float64, BigInt, BigFloat, BigRat}
+
+// DefaultPrec to domyślna precyzja big.Float w bitach mantysy (float64 ma 53).
+const DefaultPrec = 256
+
+/*
+maxResultBits ogranicza wielkość potęgi w trybach big, żeby 10^1e9 czy (10^65536)^65536 nie zajęło całej pamięci.
+Sam wykładnik nic nie mówi - liczy się rozmiar wyniku, więc szacujemy go z góry jako bity podstawy razy wykładnik
+i odmawiamy, zanim zaczniemy liczyć. Dla big.Float mantysa ma stałą precyzję, więc ograniczamy wykładnik binarny wyniku.
+*/
+const maxResultBits = 1 << 20
+
+var (
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrNotInteger     = errors.New("value is not an integer")
+	ErrOverflow       = errors.New("int64 overflow")
+	ErrExponent       = errors.New("unsupported exponent")
+	ErrTooLarge       = errors.New("result too large")
+)
+
+/*
+Value to wynik w jednym trybie. Dokładnie jedno z pól liczbowych jest ustawione, zależnie od Mode.
+Wrapped mówi, że int64 przepełnił się po drodze - wynik to wartość "zawinięta" modulo 2^64,
+tak jak zrobiłby to zwykły kod w Go.
+*/
+type Value struct {
+	Mode    Mode
+	I       int64
+	F       float64
+	Int     *big.Int
+	Float   *big.Float
+	Rat     *big.Rat
+	Wrapped bool
+	Notes   []string
+}
+
+func (v *Value) note(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	for _, n := range v.Notes {
+		if n == msg {
+			return
+		}
+	}
+	v.Notes = append(v.Notes, msg)
+}
+
+// String formatuje wartość tak, żeby pokazać wszystkie cyfry, które faktycznie przechowuje.
+func (v *Value) String() string {
+	switch v.Mode {
+	case Int64:
+		return strconv.FormatInt(v.I, 10)
+	case Float64:
+		// Liczby całkowite pokazujemy ze wszystkimi cyframi, bo najkrótszy zapis (np. 9223372036854776000) ukrywa, co jest w pamięci.
+		a := math.Abs(v.F)
+		if a < 1e21 && v.F == math.Trunc(v.F) {
+			return big.NewFloat(v.F).Text('f', 0)
+		}
+		if a >= 1e-6 && a < 1e21 {
+			return strconv.FormatFloat(v.F, 'f', -1, 64)
+		}
+		return strconv.FormatFloat(v.F, 'g', -1, 64)
+	case BigInt:
+		return v.Int.String()
+	case BigFloat:
+		digits := int(float64(v.Float.Prec()) * math.Log10(2))
+		return v.Float.Text('g', digits)
+	case BigRat:
+		if v.Rat.IsInt() {
+			return v.Rat.Num().String()
+		}
+		return v.Rat.String()
+	}
+	return "?"
+}
+
+// Exact zwraca wartość jako ułamek, żeby porównać ją ze wzorcem; nil dla Inf i NaN.
+func (v *Value) Exact() *big.Rat {
+	switch v.Mode {
+	case Int64:
+		return new(big.Rat).SetInt64(v.I)
+	case Float64:
+		if math.IsInf(v.F, 0) || math.IsNaN(v.F) {
+			return nil
+		}
+		return new(big.Rat).SetFloat64(v.F)
+	case BigInt:
+		return new(big.Rat).SetInt(v.Int)
+	case BigFloat:
+		if v.Float.IsInf() {
+			return nil
+		}
+		r, _ := v.Float.Rat(nil)
+		return r
+	case BigRat:
+		return v.Rat
+	}
+	return nil
+}
+
+// Eval liczy wyrażenie w jednym trybie; prec dotyczy tylko BigFloat (0 oznacza DefaultPrec).
+func Eval(src string, mode Mode, prec uint) (*Value, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return eval(n, mode, prec)
+}
+
+func eval(n node, mode Mode, prec uint) (*Value, error) {
+	if prec == 0 {
+		prec = DefaultPrec
+	}
+	v := &Value{Mode: mode}
+	var err error
+	switch mode {
+	case Int64:
+		v.I, err = evalInt64(n, v)
+	case Float64:
+		v.F, err = evalFloat64(n, v)
+	case BigInt:
+		v.Int, err = evalBigInt(n, v)
+	case BigFloat:
+		v.Float, err = evalBigFloat(n, v, prec)
+	case BigRat:
+		v.Rat, err = evalBigRat(n, v)
+	default:
+		err = fmt.Errorf("unknown mode %v", mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// parseExact zamienia literał na ułamek bez żadnej straty, np. "0.1" to dokładnie 1/10.
+func parseExact(text string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", text)
+	}
+	return r, nil
+}
+
+/*
+Arytmetyka int64 zachowuje się jak w Go: przepełnienie nie przerywa obliczeń, tylko zawija wynik.
+Sprawdzamy je jednak przed każdą operacją, żeby oznaczyć wynik jako Wrapped.
+*/
+func evalInt64(n node, v *Value) (int64, error) {
+	switch n := n.(type) {
+	case numberNode:
+		i, err := strconv.ParseInt(n.text, 10, 64)
+		if err == nil {
+			return i, nil
+		}
+		r, rerr := parseExact(n.text)
+		if rerr != nil {
+			return 0, rerr
+		}
+		if !r.IsInt() {
+			return 0, fmt.Errorf("%s: %w", n.text, ErrNotInteger)
+		}
+		if !r.Num().IsInt64() {
+			return 0, fmt.Errorf("constant %s: %w", n.text, ErrOverflow)
+		}
+		return r.Num().Int64(), nil
+	case unaryNode:
+		x, err := evalInt64(n.x, v)
+		if err != nil || n.op == "+" {
+			return x, err
+		}
+		if x == math.MinInt64 {
+			v.Wrapped = true
+			v.note("-(%d) wraps around", x)
+		}
+		return -x, nil
+	case binaryNode:
+		l, err := evalInt64(n.l, v)
+		if err != nil {
+			return 0, err
+		}
+		r, err := evalInt64(n.r, v)
+		if err != nil {
+			return 0, err
+		}
+		res, overflow, err := int64Op(n.op, l, r)
+		if err != nil {
+			return 0, err
+		}
+		if overflow {
+			v.Wrapped = true
+			v.note("%d %s %d wraps around", l, n.op, r)
+		}
+		if n.op == "/" && r != 0 && l%r != 0 {
+			v.note("%d / %d truncated toward zero", l, r)
+		}
+		return res, nil
+	}
+	return 0, fmt.Errorf("unknown node %T", n)
+}
+
+func int64Op(op string, a, b int64) (res int64, overflow bool, err error) {
+	switch op {
+	case "+":
+		res = a + b
+		overflow = (a > 0 && b > 0 && res < 0) || (a < 0 && b < 0 && res >= 0)
+	case "-":
+		res = a - b
+		overflow = (a >= 0 && b < 0 && res < 0) || (a < 0 && b > 0 && res >= 0)
+	case "*":
+		res = a * b
+		overflow = a != 0 && (res/a != b || (a == -1 && b == math.MinInt64))
+	case "/", "%":
+		if b == 0 {
+			return 0, false, ErrDivisionByZero
+		}
+		if op == "/" {
+			res = a / b
+			overflow = a == math.MinInt64 && b == -1
+		} else {
+			res = a % b
+		}
+	case "^":
+		if b < 0 {
+			return 0, false, fmt.Errorf("negative exponent %d: %w", b, ErrExponent)
+		}
+		// Potęgowanie przez podnoszenie do kwadratu; przepełnienie w dowolnym kroku oznacza cały wynik.
+		res = 1
+		for base := a; b > 0; b >>= 1 {
+			var o bool
+			if b&1 == 1 {
+				res, o, _ = int64Op("*", res, base)
+				overflow = overflow || o
+			}
+			if b > 1 {
+				base, o, _ = int64Op("*", base, base)
+				overflow = overflow || o
+			}
+		}
+	default:
+		return 0, false, fmt.Errorf("unknown operator %q", op)
+	}
+	return res, overflow, nil
+}
+
+func evalFloat64(n node, v *Value) (float64, error) {
+	switch n := n.(type) {
+	case numberNode:
+		f, err := strconv.ParseFloat(n.text, 64)
+		if errors.Is(err, strconv.ErrRange) {
+			v.note("literal %s out of float64 range", n.text)
+		} else if err != nil {
+			return 0, fmt.Errorf("invalid number %q", n.text)
+		}
+		if r, _ := parseExact(n.text); r != nil && !math.IsInf(f, 0) {
+			if exact := new(big.Rat).SetFloat64(f); exact.Cmp(r) != 0 {
+				v.note("literal %s rounded to %s", n.text, strconv.FormatFloat(f, 'g', 17, 64))
+			}
+		}
+		return f, nil
+	case unaryNode:
+		x, err := evalFloat64(n.x, v)
+		if n.op == "-" {
+			x = -x
+		}
+		return x, err
+	case binaryNode:
+		l, err := evalFloat64(n.l, v)
+		if err != nil {
+			return 0, err
+		}
+		r, err := evalFloat64(n.r, v)
+		if err != nil {
+			return 0, err
+		}
+		var res float64
+		switch n.op {
+		case "+":
+			res = l + r
+		case "-":
+			res = l - r
+		case "*":
+			res = l * r
+		case "/":
+			res = l / r
+		case "%":
+			res = math.Mod(l, r)
+		case "^":
+			res = math.Pow(l, r)
+		}
+		if math.IsInf(res, 0) && !math.IsInf(l, 0) && !math.IsInf(r, 0) {
+			v.note("%g %s %g overflows to %v", l, n.op, r, res)
+		}
+		return res, nil
+	}
+	return 0, fmt.Errorf("unknown node %T", n)
+}
+
+func evalBigInt(n node, v *Value) (*big.Int, error) {
+	switch n := n.(type) {
+	case numberNode:
+		r, err := parseExact(n.text)
+		if err != nil {
+			return nil, err
+		}
+		if !r.IsInt() {
+			return nil, fmt.Errorf("%s: %w", n.text, ErrNotInteger)
+		}
+		return new(big.Int).Set(r.Num()), nil
+	case unaryNode:
+		x, err := evalBigInt(n.x, v)
+		if err != nil || n.op == "+" {
+			return x, err
+		}
+		return x.Neg(x), nil
+	case binaryNode:
+		l, err := evalBigInt(n.l, v)
+		if err != nil {
+			return nil, err
+		}
+		r, err := evalBigInt(n.r, v)
+		if err != nil {
+			return nil, err
+		}
+		res := new(big.Int)
+		switch n.op {
+		case "+":
+			res.Add(l, r)
+		case "-":
+			res.Sub(l, r)
+		case "*":
+			res.Mul(l, r)
+		case "/", "%":
+			if r.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			// Quo i Rem obcinają w stronę zera, tak samo jak operatory / i % w Go.
+			var m big.Int
+			res.QuoRem(l, r, &m)
+			if n.op == "%" {
+				res.Set(&m)
+			} else if m.Sign() != 0 {
+				v.note("%s / %s truncated toward zero", l, r)
+			}
+		case "^":
+			if r.Sign() < 0 || !r.IsInt64() {
+				return nil, fmt.Errorf("exponent %s: %w", r, ErrExponent)
+			}
+			if tooLarge(intBits(l), r.Int64()) {
+				return nil, fmt.Errorf("%s ^ %s: %w", abbreviate(l.String()), r, ErrTooLarge)
+			}
+			res.Exp(l, r, nil)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("unknown node %T", n)
+}
+
+func evalBigFloat(n node, v *Value, prec uint) (*big.Float, error) {
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	switch n := n.(type) {
+	case numberNode:
+		f, _, err := big.ParseFloat(n.text, 10, prec, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", n.text)
+		}
+		if f.Acc() != big.Exact {
+			v.note("literal %s rounded to %d bits", n.text, prec)
+		}
+		return f, nil
+	case unaryNode:
+		x, err := evalBigFloat(n.x, v, prec)
+		if err != nil || n.op == "+" {
+			return x, err
+		}
+		return x.Neg(x), nil
+	case binaryNode:
+		l, err := evalBigFloat(n.l, v, prec)
+		if err != nil {
+			return nil, err
+		}
+		r, err := evalBigFloat(n.r, v, prec)
+		if err != nil {
+			return nil, err
+		}
+		res := newFloat()
+		switch n.op {
+		case "+":
+			res.Add(l, r)
+		case "-":
+			res.Sub(l, r)
+		case "*":
+			res.Mul(l, r)
+		case "/":
+			if r.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			res.Quo(l, r)
+		case "%":
+			if r.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			// l - trunc(l/r)*r, czyli ta sama definicja co math.Mod.
+			q := newFloat().Quo(l, r)
+			qi, _ := q.Int(nil)
+			res.Sub(l, newFloat().Mul(newFloat().SetInt(qi), r))
+		case "^":
+			return bigFloatPow(l, r, v, prec)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("unknown node %T", n)
+}
+
+/*
+bigFloatPow liczy potęgę całkowitą przez podnoszenie do kwadratu z pełną precyzją.
+math/big nie ma funkcji Pow ani Log, więc wykładnik niecałkowity liczymy przez float64 - to jest zaznaczone w Notes.
+*/
+func bigFloatPow(x, y *big.Float, v *Value, prec uint) (*big.Float, error) {
+	if y.IsInt() {
+		e, _ := y.Int(nil)
+		neg := e.Sign() < 0
+		e.Abs(e)
+		if !e.IsInt64() {
+			return nil, fmt.Errorf("exponent %s: %w", y.Text('g', 10), ErrExponent)
+		}
+		// x = m * 2^exp, gdzie 0.5 <= |m| < 1, więc |x^e| mieści się między 2^((exp-1)*e) a 2^(exp*e).
+		bits := 0
+		if x.Sign() != 0 && new(big.Float).Abs(x).Cmp(big.NewFloat(1)) != 0 {
+			if exp := x.MantExp(nil); exp > 0 {
+				bits = exp
+			} else {
+				bits = 1 - exp
+			}
+		}
+		if tooLarge(bits, e.Int64()) {
+			return nil, fmt.Errorf("%s ^ %s: %w", x.Text('g', 10), y.Text('g', 10), ErrTooLarge)
+		}
+		res := new(big.Float).SetPrec(prec).SetInt64(1)
+		base := new(big.Float).SetPrec(prec).Set(x)
+		for k := e.Int64(); k > 0; k >>= 1 {
+			if k&1 == 1 {
+				res.Mul(res, base)
+			}
+			base.Mul(base, base)
+		}
+		if neg {
+			if res.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			res.Quo(new(big.Float).SetPrec(prec).SetInt64(1), res)
+		}
+		return res, nil
+	}
+	xf, _ := x.Float64()
+	yf, _ := y.Float64()
+	v.note("non-integer exponent computed in float64")
+	res := math.Pow(xf, yf)
+	if math.IsNaN(res) {
+		return nil, fmt.Errorf("%g ^ %g is not a real number: %w", xf, yf, ErrExponent)
+	}
+	return new(big.Float).SetPrec(prec).SetFloat64(res), nil
+}
+
+func evalBigRat(n node, v *Value) (*big.Rat, error) {
+	switch n := n.(type) {
+	case numberNode:
+		return parseExact(n.text)
+	case unaryNode:
+		x, err := evalBigRat(n.x, v)
+		if err != nil || n.op == "+" {
+			return x, err
+		}
+		return x.Neg(x), nil
+	case binaryNode:
+		l, err := evalBigRat(n.l, v)
+		if err != nil {
+			return nil, err
+		}
+		r, err := evalBigRat(n.r, v)
+		if err != nil {
+			return nil, err
+		}
+		res := new(big.Rat)
+		switch n.op {
+		case "+":
+			res.Add(l, r)
+		case "-":
+			res.Sub(l, r)
+		case "*":
+			res.Mul(l, r)
+		case "/":
+			if r.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			res.Quo(l, r)
+		case "%":
+			if r.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			// Reszta z dzielenia ułamków: l - trunc(l/r)*r, dalej dokładnie.
+			q := new(big.Rat).Quo(l, r)
+			qi := new(big.Int).Quo(q.Num(), q.Denom())
+			res.Sub(l, new(big.Rat).Mul(new(big.Rat).SetInt(qi), r))
+		case "^":
+			// Potęga o wykładniku ułamkowym zwykle jest niewymierna, więc Rat jej nie przedstawi.
+			if !r.IsInt() || !r.Num().IsInt64() {
+				return nil, fmt.Errorf("exponent %s: %w", r.RatString(), ErrExponent)
+			}
+			e := r.Num().Int64()
+			if e == math.MinInt64 || tooLarge(max(intBits(l.Num()), intBits(l.Denom())), abs(e)) {
+				return nil, fmt.Errorf("%s ^ %d: %w", abbreviate(l.RatString()), e, ErrTooLarge)
+			}
+			num := new(big.Int).Exp(l.Num(), big.NewInt(abs(e)), nil)
+			den := new(big.Int).Exp(l.Denom(), big.NewInt(abs(e)), nil)
+			if e < 0 {
+				num, den = den, num
+			}
+			if den.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+			res.SetFrac(num, den)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("unknown node %T", n)
+}
+
+// tooLarge mówi, czy potęga podstawy o podanej liczbie bitów przekroczy maxResultBits; bits == 0 oznacza podstawę, której potęgi nie rosną.
+func tooLarge(bits int, exp int64) bool {
+	if bits == 0 || exp <= 1 {
+		return false
+	}
+	return exp > maxResultBits/int64(bits)
+}
+
+// intBits zwraca liczbę bitów x albo 0 dla 0, 1 i -1, bo ich potęgi mają zawsze co najwyżej 1 bit.
+func intBits(x *big.Int) int {
+	if x.BitLen() <= 1 {
+		return 0
+	}
+	return x.BitLen()
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
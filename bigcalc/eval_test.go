@@ -0,0 +1,98 @@
+package bigcalc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var bigModes = []Mode{BigInt, BigFloat, BigRat}
+
+// TestTooLarge sprawdza, że ograniczamy rozmiar wyniku, a nie sam wykładnik - (10^65536)^65536 liczyło się w nieskończoność.
+func TestTooLarge(t *testing.T) {
+	exprs := []string{
+		"(10^65536)^65536",
+		"2^1048577",
+		"(2^1000)^2000",
+		"10^1000000000",
+		"(-3)^9999999",
+	}
+	for _, expr := range exprs {
+		for _, mode := range bigModes {
+			start := time.Now()
+			_, err := Eval(expr, mode, 0)
+			if !errors.Is(err, ErrTooLarge) {
+				t.Errorf("Eval(%q, %v) error = %v, want ErrTooLarge", expr, mode, err)
+			}
+			if d := time.Since(start); d > time.Second {
+				t.Errorf("Eval(%q, %v) took %v", expr, mode, d)
+			}
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"10^65536 / 10^65535", "10"},
+		{"2^500000 / 2^499999", "2"},
+		{"1^1000000000000", "1"},
+		{"(-1)^1000000000001", "-1"},
+		{"0^1000000000000", "0"},
+		{"2^10", "1024"},
+	}
+	for _, tt := range tests {
+		for _, mode := range bigModes {
+			v, err := Eval(tt.expr, mode, 0)
+			if err != nil {
+				t.Errorf("Eval(%q, %v) error: %v", tt.expr, mode, err)
+				continue
+			}
+			if got := v.String(); got != tt.want {
+				t.Errorf("Eval(%q, %v) = %s, want %s", tt.expr, mode, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestNegativeExponent(t *testing.T) {
+	for _, expr := range []string{"(1/2)^-1048577", "(2/3)^-2000000"} {
+		if _, err := Eval(expr, BigRat, 0); !errors.Is(err, ErrTooLarge) {
+			t.Errorf("Eval(%q, BigRat) error = %v, want ErrTooLarge", expr, err)
+		}
+	}
+	v, err := Eval("(1/2)^-10", BigRat, 0)
+	if err != nil || v.String() != "1024" {
+		t.Errorf("Eval((1/2)^-10, BigRat) = %v, %v; want 1024", v, err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		expr  string
+		want  []Status // w kolejności Modes
+		lossy bool
+	}{
+		{"1 + 2", []Status{Exact, Exact, Exact, Exact, Exact}, false},
+		{"0.1 + 0.2", []Status{Failed, Rounded, Failed, Rounded, Exact}, true},
+		{"2^63", []Status{Wrapped, Exact, Exact, Exact, Exact}, true},
+		{"1/0", []Status{Failed, Unknown, Failed, Failed, Failed}, false},
+		{"(10^65536)^65536", []Status{Wrapped, Unknown, Failed, Failed, Failed}, true},
+	}
+	for _, tt := range tests {
+		rep, err := Compare(tt.expr, 0)
+		if err != nil {
+			t.Fatalf("Compare(%q): %v", tt.expr, err)
+		}
+		for i, row := range rep.Rows {
+			if row.Status != tt.want[i] {
+				t.Errorf("Compare(%q) %v: %v (%v), want %v", tt.expr, row.Mode, row.Status, row.Err, tt.want[i])
+			}
+		}
+		if got := rep.Lossy(); got != tt.lossy {
+			t.Errorf("Compare(%q).Lossy() = %v, want %v", tt.expr, got, tt.lossy)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Pakiet bigcalc jest kalkulatorem wyrażeń, który liczy to samo wyrażenie w kilku reprezentacjach:
+int64, float64 oraz typach dowolnej precyzji z math/big (Int, Float, Rat).
+
+Porównanie wyników pokazuje, w którym miejscu konwersja do typu o stałym rozmiarze traci informację:
+int64 przepełnia się (ang. overflow) i "zawija", a float64 zaokrągla do 53 bitów mantysy.
+big.Rat liczy ułamki dokładnie i służy jako wzorzec.
+
+Obsługiwane są operatory + - * / % ^ oraz nawiasy; ^ (potęgowanie) wiąże w prawo i mocniej niż minus jednoargumentowy.
+*/
+package bigcalc
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SyntaxError wskazuje pozycję (od 1) w wyrażeniu, w której wystąpił błąd.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos, e.Msg)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+			// Wykładnik dziesiętny, np. 1e10 lub 2.5E-3.
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			text := strings.ReplaceAll(string(runes[start:i]), "_", "")
+			if strings.Count(text, ".") > 1 || text == "." {
+				return nil, &SyntaxError{start + 1, fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, token{tokNumber, text, start + 1})
+		case strings.ContainsRune("+-*/%^", r):
+			tokens = append(tokens, token{tokOp, string(r), i + 1})
+			i++
+		case r == '(':
+			tokens = append(tokens, token{tokLParen, "(", i + 1})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{tokRParen, ")", i + 1})
+			i++
+		default:
+			return nil, &SyntaxError{i + 1, fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return append(tokens, token{tokEOF, "", len(runes) + 1}), nil
+}
+
+// Węzły drzewa składniowego.
+type node interface {
+	String() string
+}
+
+type numberNode struct {
+	text string
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+func (n numberNode) String() string { return n.text }
+
+func (n unaryNode) String() string { return "(" + n.op + n.x.String() + ")" }
+
+func (n binaryNode) String() string {
+	return "(" + n.l.String() + " " + n.op + " " + n.r.String() + ")"
+}
+
+/*
+parser to parser zstępujący (ang. recursive descent) z jedną funkcją na poziom priorytetu:
+
+	expr   = term { ("+" | "-") term }
+	term   = unary { ("*" | "/" | "%") unary }
+	unary  = ("-" | "+") unary | power
+	power  = atom [ "^" unary ]
+	atom   = number | "(" expr ")"
+*/
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parse(src string) (node, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &SyntaxError{t.pos, fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(ops string) bool {
+	t := p.peek()
+	return t.kind == tokOp && strings.Contains(ops, t.text)
+}
+
+func (p *parser) expr() (node, error) {
+	l, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+-") {
+		op := p.next().text
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op, l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) term() (node, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*/%") {
+		op := p.next().text
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op, l, r}
+	}
+	return l, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.isOp("+-") {
+		op := p.next().text
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op, x}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (node, error) {
+	base, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("^") {
+		p.next()
+		exp, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{"^", base, exp}, nil
+	}
+	return base, nil
+}
+
+func (p *parser) atom() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		return numberNode{t.text}, nil
+	case tokLParen:
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &SyntaxError{closing.pos, "expected \")\""}
+		}
+		return n, nil
+	case tokEOF:
+		return nil, &SyntaxError{t.pos, "unexpected end of expression"}
+	default:
+		return nil, &SyntaxError{t.pos, fmt.Sprintf("unexpected %q", t.text)}
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Pakiet breaker implementuje wyłącznik obwodu (ang. circuit breaker).
+
+Wyłącznik chroni system przed wielokrotnym wywoływaniem usługi, która właśnie nie działa:
+  - zamknięty (closed) - wywołania przechodzą, a wyniki są zliczane w przesuwającym się oknie czasowym;
+    gdy odsetek porażek przekroczy próg, wyłącznik się otwiera,
+  - otwarty (open) - wywołania są od razu odrzucane z ErrOpen, aż minie czas schłodzenia (Cooldown),
+  - półotwarty (half-open) - przepuszczana jest ograniczona liczba wywołań próbnych;
+    jeśli wszystkie się udadzą, wyłącznik się zamyka, a jeśli któreś zawiedzie - znów otwiera.
+
+Stany są raportowane jako basics.ServerState:
+closed = StateConnected, open = StateError, half-open = StateRetrying.
+*/
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"lets-go/basics"
+)
+
+var (
+	ErrOpen          = errors.New("circuit breaker is open")
+	ErrTooManyProbes = errors.New("circuit breaker is half-open and all probes are in flight")
+)
+
+const (
+	Closed   = basics.StateConnected
+	Open     = basics.StateError
+	HalfOpen = basics.StateRetrying
+)
+
+/*
+Settings konfiguruje wyłącznik. Wartości zerowe zastępowane są domyślnymi:
+okno 10s w 10 kubełkach, próg 50% porażek przy co najmniej 10 wywołaniach, schłodzenie 5s i 1 wywołanie próbne.
+Kubełków nie może być więcej niż nanosekund w oknie; nadmiarowe są pomijane.
+*/
+type Settings struct {
+	Window      time.Duration
+	Buckets     int
+	FailureRate float64
+	MinRequests int
+	Cooldown    time.Duration
+
+	// HalfOpenProbes to jednocześnie limit równoległych wywołań próbnych
+	// i liczba udanych prób potrzebna do zamknięcia wyłącznika.
+	HalfOpenProbes int
+
+	// IsFailure decyduje, które błędy liczą się jako porażka. Domyślnie każdy błąd różny od nil.
+	IsFailure func(err error) bool
+
+	OnStateChange func(from, to basics.ServerState)
+
+	// Now pozwala podstawić własny zegar; domyślnie time.Now.
+	Now func() time.Time
+}
+
+type Breaker struct {
+	s Settings
+
+	mu       sync.Mutex
+	state    basics.ServerState
+	window   *window
+	openedAt time.Time
+	inFlight int
+	probesOK int
+
+	// generation rośnie przy każdej zmianie stanu, żeby wyniki wywołań
+	// rozpoczętych w poprzednim stanie nie wpływały na bieżący.
+	generation uint64
+	pending    []change
+}
+
+type change struct {
+	from, to basics.ServerState
+}
+
+func New(s Settings) *Breaker {
+	if s.Window <= 0 {
+		s.Window = 10 * time.Second
+	}
+	if s.Buckets <= 0 {
+		s.Buckets = 10
+	}
+	// Kubełek musi trwać co najmniej 1ns - przy Window < Buckets ns jego długość wyszłaby 0 i dzielenie w window spanikowałoby.
+	if time.Duration(s.Buckets) > s.Window {
+		s.Buckets = int(s.Window)
+	}
+	if s.FailureRate <= 0 {
+		s.FailureRate = 0.5
+	}
+	if s.MinRequests <= 0 {
+		s.MinRequests = 10
+	}
+	if s.Cooldown <= 0 {
+		s.Cooldown = 5 * time.Second
+	}
+	if s.HalfOpenProbes <= 0 {
+		s.HalfOpenProbes = 1
+	}
+	if s.IsFailure == nil {
+		s.IsFailure = func(err error) bool { return err != nil }
+	}
+	if s.Now == nil {
+		s.Now = time.Now
+	}
+	return &Breaker{s: s, state: Closed, window: newWindow(s.Window, s.Buckets)}
+}
+
+// State zwraca bieżący stan; otwarty wyłącznik po upływie schłodzenia raportuje się jako półotwarty.
+func (b *Breaker) State() basics.ServerState {
+	b.mu.Lock()
+	b.refresh(b.s.Now())
+	state := b.state
+	changes := b.takePending()
+	b.mu.Unlock()
+
+	b.notify(changes)
+	return state
+}
+
+// Counts zwraca liczbę sukcesów i porażek w bieżącym oknie.
+func (b *Breaker) Counts() (successes, failures int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.window.counts(b.s.Now())
+}
+
+// Execute wywołuje fn, jeśli wyłącznik na to pozwala, i zapisuje wynik.
+func (b *Breaker) Execute(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+	err = fn()
+	done(err)
+	return err
+}
+
+/*
+Allow to dwuetapowa wersja Execute dla kodu, który nie mieści się w jednej funkcji:
+po zakończeniu operacji należy wywołać zwróconą funkcję done z jej wynikiem.
+*/
+func (b *Breaker) Allow() (done func(err error), err error) {
+	b.mu.Lock()
+	now := b.s.Now()
+	b.refresh(now)
+
+	switch b.state {
+	case Open:
+		err = ErrOpen
+	case HalfOpen:
+		if b.inFlight >= b.s.HalfOpenProbes {
+			err = ErrTooManyProbes
+		}
+	}
+	if err == nil {
+		b.inFlight++
+	}
+	gen := b.generation
+	changes := b.takePending()
+	b.mu.Unlock()
+
+	b.notify(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { b.done(gen, !b.s.IsFailure(err)) })
+	}, nil
+}
+
+func (b *Breaker) done(gen uint64, success bool) {
+	b.mu.Lock()
+	now := b.s.Now()
+	if gen == b.generation {
+		b.inFlight--
+		b.record(now, success)
+	}
+	changes := b.takePending()
+	b.mu.Unlock()
+
+	b.notify(changes)
+}
+
+func (b *Breaker) record(now time.Time, success bool) {
+	switch b.state {
+	case Closed:
+		b.window.record(now, success)
+		successes, failures := b.window.counts(now)
+		total := successes + failures
+		if total >= b.s.MinRequests && float64(failures)/float64(total) >= b.s.FailureRate {
+			b.setState(Open, now)
+		}
+	case HalfOpen:
+		if !success {
+			b.setState(Open, now)
+			return
+		}
+		b.probesOK++
+		if b.probesOK >= b.s.HalfOpenProbes {
+			b.setState(Closed, now)
+		}
+	}
+}
+
+// refresh przełącza otwarty wyłącznik w stan półotwarty, gdy minie czas schłodzenia.
+func (b *Breaker) refresh(now time.Time) {
+	if b.state == Open && now.Sub(b.openedAt) >= b.s.Cooldown {
+		b.setState(HalfOpen, now)
+	}
+}
+
+func (b *Breaker) setState(to basics.ServerState, now time.Time) {
+	from := b.state
+	if from == to {
+		return
+	}
+	b.state = to
+	b.generation++
+	b.inFlight = 0
+	b.probesOK = 0
+
+	switch to {
+	case Open:
+		b.openedAt = now
+	case Closed:
+		b.window.reset()
+	}
+	b.pending = append(b.pending, change{from, to})
+}
+
+func (b *Breaker) takePending() []change {
+	changes := b.pending
+	b.pending = nil
+	return changes
+}
+
+// Callback wywołujemy poza blokadą, żeby mógł bezpiecznie odczytać stan wyłącznika.
+func (b *Breaker) notify(changes []change) {
+	if b.s.OnStateChange == nil {
+		return
+	}
+	for _, c := range changes {
+		b.s.OnStateChange(c.from, c.to)
+	}
+}
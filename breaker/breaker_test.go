@@ -0,0 +1,167 @@
+package breaker
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"lets-go/basics"
+)
+
+// TestTinyWindow sprawdza okno krótsze niż liczba kubełków w nanosekundach - wcześniej długość kubełka wynosiła 0.
+func TestTinyWindow(t *testing.T) {
+	now := time.Unix(0, 0)
+	for _, window := range []time.Duration{1, 5, 9} {
+		b := New(Settings{Window: window, Buckets: 10, MinRequests: 2, Now: func() time.Time { return now }})
+		fail := errors.New("fail")
+		b.Execute(func() error { return fail })
+		b.Execute(func() error { return fail })
+		if got := b.State(); got != Open {
+			t.Errorf("window %v: state %v after 2 failures, want %v", window, got, Open)
+		}
+	}
+}
+
+func TestWindowExpires(t *testing.T) {
+	now := time.Unix(100, 0)
+	b := New(Settings{Window: time.Second, Buckets: 4, MinRequests: 3, Now: func() time.Time { return now }})
+	b.Execute(func() error { return errors.New("fail") })
+	b.Execute(func() error { return nil })
+	if s, f := b.Counts(); s != 1 || f != 1 {
+		t.Fatalf("Counts() = %d, %d; want 1, 1", s, f)
+	}
+	now = now.Add(time.Second)
+	if s, f := b.Counts(); s != 0 || f != 0 {
+		t.Errorf("Counts() after the window = %d, %d; want 0, 0", s, f)
+	}
+}
+
+// TestPreEpochClock sprawdza zegary sprzed 1970 roku - wcześniej ujemna epoka dawała ujemny indeks kubełka.
+func TestPreEpochClock(t *testing.T) {
+	starts := []time.Time{
+		{},
+		time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Unix(0, -1),
+	}
+	for _, start := range starts {
+		now := start
+		b := New(Settings{Window: time.Second, Buckets: 4, MinRequests: 100, Now: func() time.Time { return now }})
+		for i := 0; i < 8; i++ {
+			b.Execute(func() error { return errors.New("fail") })
+			now = now.Add(100 * time.Millisecond)
+		}
+		// Ostatnie porażki z 0.1s, 0.2s, ..., 0.8s; okno 1s kończy się na 0.8s, więc obejmuje wszystkie.
+		now = now.Add(-100 * time.Millisecond)
+		if s, f := b.Counts(); s != 0 || f != 8 {
+			t.Errorf("start %v: Counts() = %d, %d; want 0, 8", start, s, f)
+		}
+		// Zegar cofnięty przed pierwszy odczyt też nie może spanikować.
+		now = start.Add(-time.Hour)
+		b.Execute(func() error { return nil })
+		if s, f := b.Counts(); s != 1 || f != 0 {
+			t.Errorf("start %v: Counts() after going back = %d, %d; want 1, 0", start, s, f)
+		}
+	}
+}
+
+// fakeBreaker zwraca wyłącznik z ręcznie przesuwanym zegarem i listą zmian stanu.
+func fakeBreaker(s Settings) (b *Breaker, now *time.Time, changes *[]string) {
+	now = new(time.Time)
+	*now = time.Unix(1000, 0)
+	changes = new([]string)
+	s.Now = func() time.Time { return *now }
+	s.OnStateChange = func(from, to basics.ServerState) {
+		*changes = append(*changes, from.String()+"->"+to.String())
+	}
+	return New(s), now, changes
+}
+
+func TestCooldown(t *testing.T) {
+	b, now, _ := fakeBreaker(Settings{MinRequests: 1, Cooldown: 5 * time.Second})
+	b.Execute(func() error { return errors.New("fail") })
+	if got := b.State(); got != Open {
+		t.Fatalf("state %v after a failure, want %v", got, Open)
+	}
+
+	*now = now.Add(5*time.Second - 1)
+	called := false
+	if err := b.Execute(func() error { called = true; return nil }); !errors.Is(err, ErrOpen) || called {
+		t.Errorf("Execute before the cooldown = %v (called %v), want ErrOpen", err, called)
+	}
+
+	*now = now.Add(1)
+	if got := b.State(); got != HalfOpen {
+		t.Errorf("state %v after the cooldown, want %v", got, HalfOpen)
+	}
+}
+
+func TestHalfOpen(t *testing.T) {
+	fail := errors.New("fail")
+	tests := []struct {
+		name    string
+		probes  []error
+		want    basics.ServerState
+		changes []string
+	}{
+		{"probe fails", []error{fail}, Open, []string{"connected->error", "error->retrying", "retrying->error"}},
+		{"one probe ok", []error{nil}, HalfOpen, []string{"connected->error", "error->retrying"}},
+		{"all probes ok", []error{nil, nil}, Closed, []string{"connected->error", "error->retrying", "retrying->connected"}},
+		{"second probe fails", []error{nil, fail}, Open, []string{"connected->error", "error->retrying", "retrying->error"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, now, changes := fakeBreaker(Settings{MinRequests: 1, Cooldown: time.Second, HalfOpenProbes: 2})
+			b.Execute(func() error { return fail })
+			*now = now.Add(time.Second)
+			for _, err := range tt.probes {
+				b.Execute(func() error { return err })
+			}
+			if got := b.State(); got != tt.want {
+				t.Errorf("state %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(*changes, tt.changes) {
+				t.Errorf("changes %v, want %v", *changes, tt.changes)
+			}
+		})
+	}
+}
+
+// TestProbeLimit sprawdza, że w stanie półotwartym naraz działa najwyżej HalfOpenProbes wywołań.
+func TestProbeLimit(t *testing.T) {
+	b, now, _ := fakeBreaker(Settings{MinRequests: 1, Cooldown: time.Second, HalfOpenProbes: 2})
+	b.Execute(func() error { return errors.New("fail") })
+	*now = now.Add(time.Second)
+
+	done1, err1 := b.Allow()
+	done2, err2 := b.Allow()
+	if err1 != nil || err2 != nil {
+		t.Fatalf("Allow() = %v, %v; want two probes", err1, err2)
+	}
+	if _, err := b.Allow(); !errors.Is(err, ErrTooManyProbes) {
+		t.Errorf("third Allow() = %v, want ErrTooManyProbes", err)
+	}
+	done1(nil)
+	done2(nil)
+	if got := b.State(); got != Closed {
+		t.Errorf("state %v after two successful probes, want %v", got, Closed)
+	}
+	if _, err := b.Allow(); err != nil {
+		t.Errorf("Allow() after closing = %v, want nil", err)
+	}
+}
+
+// TestStaleResult sprawdza, że wynik wywołania rozpoczętego przed otwarciem nie liczy się jako próba.
+func TestStaleResult(t *testing.T) {
+	b, now, _ := fakeBreaker(Settings{MinRequests: 1, Cooldown: time.Second})
+	stale, err := b.Allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Execute(func() error { return errors.New("fail") })
+	*now = now.Add(time.Second)
+	stale(nil)
+	if got := b.State(); got != HalfOpen {
+		t.Errorf("state %v after a stale success, want %v", got, HalfOpen)
+	}
+}
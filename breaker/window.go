@@ -0,0 +1,75 @@
+package breaker
+
+import "time"
+
+/*
+window liczy sukcesy i porażki w przesuwającym się oknie czasowym.
+Okno jest podzielone na kubełki (ang. buckets); kubełek, którego czas minął, jest zerowany
+przy ponownym użyciu, więc stare wyniki same "wypadają" z okna bez osobnego sprzątania.
+Epoki liczymy od pierwszego odczytu zegara, a nie od 1970 roku - podstawiony zegar może wskazywać
+dowolną datę, także time.Time{}, dla której UnixNano nie ma sensu.
+*/
+type window struct {
+	size    time.Duration
+	buckets []bucket
+	start   time.Time
+	started bool
+}
+
+type bucket struct {
+	epoch     int64
+	successes int
+	failures  int
+}
+
+func newWindow(size time.Duration, n int) *window {
+	return &window{size: size, buckets: make([]bucket, n)}
+}
+
+func (w *window) bucketSize() time.Duration {
+	return w.size / time.Duration(len(w.buckets))
+}
+
+func (w *window) epoch(now time.Time) int64 {
+	if !w.started {
+		w.start, w.started = now, true
+	}
+	d, size := now.Sub(w.start), w.bucketSize()
+	e := int64(d / size)
+	// Zegar cofnięty przed start daje ujemne d; dzielenie ma zaokrąglać w dół, a nie do zera.
+	if d%size < 0 {
+		e--
+	}
+	return e
+}
+
+func (w *window) record(now time.Time, success bool) {
+	e := w.epoch(now)
+	n := int64(len(w.buckets))
+	b := &w.buckets[(e%n+n)%n]
+	if b.epoch != e {
+		*b = bucket{epoch: e}
+	}
+	if success {
+		b.successes++
+	} else {
+		b.failures++
+	}
+}
+
+// counts sumuje kubełki, które nadal mieszczą się w oknie.
+func (w *window) counts(now time.Time) (successes, failures int) {
+	current := w.epoch(now)
+	oldest := current - int64(len(w.buckets)) + 1
+	for _, b := range w.buckets {
+		if b.epoch >= oldest && b.epoch <= current {
+			successes += b.successes
+			failures += b.failures
+		}
+	}
+	return successes, failures
+}
+
+func (w *window) reset() {
+	clear(w.buckets)
+}
@@ -0,0 +1,265 @@
+package calc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Func to funkcja dostępna w wyrażeniach. Arity -1 oznacza dowolną liczbę argumentów.
+type Func struct {
+	Arity int
+	Fn    func(args []Value) (Value, error)
+}
+
+// Interpreter przechowuje zmienne i funkcje między kolejnymi liniami.
+type Interpreter struct {
+	vars  map[string]Value
+	funcs map[string]Func
+}
+
+/*
+New tworzy interpreter ze stałymi pi i e oraz funkcjami pow, hypot, abs, min i max.
+sqrt celowo nie jest wbudowane - rejestruje je ten, kto tworzy interpreter (lekcja basics używa własnego Sqrt).
+*/
+func New() *Interpreter {
+	in := &Interpreter{vars: map[string]Value{}, funcs: map[string]Func{}}
+	in.Set("pi", Float(math.Pi))
+	in.Set("e", Float(math.E))
+	in.Register("pow", 2, func(args []Value) (Value, error) {
+		return binary("^", args[0], args[1])
+	})
+	in.RegisterFloat("hypot", math.Hypot)
+	in.Register("abs", 1, func(args []Value) (Value, error) {
+		v := args[0]
+		if !v.IsInt() {
+			return Float(math.Abs(v.f)), nil
+		}
+		if v.i < 0 {
+			return negate(v)
+		}
+		return v, nil
+	})
+	pick := func(less func(a, b float64) bool) func([]Value) (Value, error) {
+		return func(args []Value) (Value, error) {
+			if len(args) == 0 {
+				return Value{}, errors.New("needs at least one argument")
+			}
+			best := args[0]
+			for _, a := range args[1:] {
+				if less(a.Float64(), best.Float64()) {
+					best = a
+				}
+			}
+			return best, nil
+		}
+	}
+	in.Register("min", -1, pick(func(a, b float64) bool { return a < b }))
+	in.Register("max", -1, pick(func(a, b float64) bool { return a > b }))
+	return in
+}
+
+// Register dodaje (lub podmienia) funkcję name.
+func (in *Interpreter) Register(name string, arity int, fn func(args []Value) (Value, error)) {
+	in.funcs[name] = Func{arity, fn}
+}
+
+/*
+RegisterFloat dodaje funkcję o sygnaturze func(float64...) float64, np. math.Hypot.
+Wynik NaN (np. sqrt(-1)) jest zgłaszany jako błąd, zamiast po cichu trafić do dalszych obliczeń.
+*/
+func (in *Interpreter) RegisterFloat(name string, fn any) {
+	var arity int
+	var call func([]float64) float64
+	switch fn := fn.(type) {
+	case func(float64) float64:
+		arity, call = 1, func(x []float64) float64 { return fn(x[0]) }
+	case func(float64, float64) float64:
+		arity, call = 2, func(x []float64) float64 { return fn(x[0], x[1]) }
+	default:
+		panic(fmt.Sprintf("calc: unsupported function type %T for %s", fn, name))
+	}
+	in.Register(name, arity, func(args []Value) (Value, error) {
+		xs := make([]float64, len(args))
+		for i, a := range args {
+			xs[i] = a.Float64()
+		}
+		r := call(xs)
+		if math.IsNaN(r) {
+			return Value{}, errors.New("result is not a number")
+		}
+		return Float(r), nil
+	})
+}
+
+// Set ustawia zmienną.
+func (in *Interpreter) Set(name string, v Value) {
+	in.vars[name] = v
+}
+
+// Get zwraca zmienną.
+func (in *Interpreter) Get(name string) (Value, bool) {
+	v, ok := in.vars[name]
+	return v, ok
+}
+
+/*
+EvalLine liczy jedną linię (line to jej numer, używany w błędach).
+Zwraca ok == false dla linii pustych i komentarzy. Przypisanie zwraca przypisaną wartość.
+*/
+func (in *Interpreter) EvalLine(line int, src string) (v Value, ok bool, err error) {
+	n, err := parseLine(line, src)
+	if err != nil || n == nil {
+		return Value{}, false, err
+	}
+	v, err = in.eval(n)
+	return v, err == nil, err
+}
+
+func (in *Interpreter) eval(n node) (Value, error) {
+	switch n := n.(type) {
+	case numberNode:
+		return n.val, nil
+	case identNode:
+		v, ok := in.vars[n.name]
+		if !ok {
+			if _, isFunc := in.funcs[n.name]; isFunc {
+				return Value{}, errorf(n.pos, "%s is a function, call it as %s(...)", n.name, n.name)
+			}
+			return Value{}, errorf(n.pos, "undefined variable %s", n.name)
+		}
+		return v, nil
+	case unaryNode:
+		x, err := in.eval(n.x)
+		if err != nil || n.op == "+" {
+			return x, err
+		}
+		v, err := negate(x)
+		if err != nil {
+			return Value{}, errorf(n.pos, "%v", err)
+		}
+		return v, nil
+	case binaryNode:
+		l, err := in.eval(n.l)
+		if err != nil {
+			return Value{}, err
+		}
+		r, err := in.eval(n.r)
+		if err != nil {
+			return Value{}, err
+		}
+		v, err := binary(n.op, l, r)
+		if err != nil {
+			return Value{}, errorf(n.pos, "%s %s %s: %v", l, n.op, r, err)
+		}
+		return v, nil
+	case assignNode:
+		if _, isFunc := in.funcs[n.name]; isFunc {
+			return Value{}, errorf(n.pos, "cannot assign to function %s", n.name)
+		}
+		v, err := in.eval(n.x)
+		if err != nil {
+			return Value{}, err
+		}
+		in.vars[n.name] = v
+		return v, nil
+	case callNode:
+		fn, ok := in.funcs[n.name]
+		if !ok {
+			return Value{}, errorf(n.pos, "undefined function %s", n.name)
+		}
+		if fn.Arity >= 0 && len(n.args) != fn.Arity {
+			return Value{}, errorf(n.pos, "%s expects %d argument(s), got %d", n.name, fn.Arity, len(n.args))
+		}
+		args := make([]Value, len(n.args))
+		for i, a := range n.args {
+			v, err := in.eval(a)
+			if err != nil {
+				return Value{}, err
+			}
+			args[i] = v
+		}
+		v, err := fn.Fn(args)
+		if err != nil {
+			return Value{}, errorf(n.pos, "%s: %v", n.name, err)
+		}
+		return v, nil
+	}
+	return Value{}, fmt.Errorf("calc: unknown node %T", n)
+}
+
+/*
+REPL czyta linie z r i wypisuje wynik każdej z nich do w (ang. read-eval-print loop).
+Błąd nie przerywa pracy - jest wypisywany i można pisać dalej. Polecenie "vars" pokazuje zmienne.
+*/
+func (in *Interpreter) REPL(r io.Reader, w io.Writer) error {
+	sc := bufio.NewScanner(r)
+	fmt.Fprint(w, "> ")
+	for line := 1; sc.Scan(); line++ {
+		switch strings.TrimSpace(sc.Text()) {
+		case "vars":
+			in.writeVars(w)
+		case "quit", "exit":
+			return nil
+		default:
+			v, ok, err := in.EvalLine(line, sc.Text())
+			switch {
+			case err != nil:
+				fmt.Fprintln(w, "error:", err)
+			case ok:
+				fmt.Fprintln(w, v)
+			}
+		}
+		fmt.Fprint(w, "> ")
+	}
+	fmt.Fprintln(w)
+	return sc.Err()
+}
+
+/*
+Batch liczy kolejne linie z r jak skrypt i wypisuje wyniki wyrażeń do w (przypisania są ciche).
+Błędy trafiają do osobnego errw w formacie name:linia:kolumna - tak jak stdout i stderr w programach
+konsolowych, dzięki czemu wyniki można przekierować do pliku bez komunikatów o błędach.
+Na końcu zwracany jest błąd z liczbą nieudanych linii.
+*/
+func (in *Interpreter) Batch(name string, r io.Reader, w, errw io.Writer) error {
+	sc := bufio.NewScanner(r)
+	failed := 0
+	for line := 1; sc.Scan(); line++ {
+		n, err := parseLine(line, sc.Text())
+		if err == nil && n != nil {
+			var v Value
+			if v, err = in.eval(n); err == nil {
+				if _, isAssign := n.(assignNode); !isAssign {
+					fmt.Fprintln(w, v)
+				}
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(errw, "%s:%v\n", name, err)
+			failed++
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%s: %d line(s) failed", name, failed)
+	}
+	return nil
+}
+
+func (in *Interpreter) writeVars(w io.Writer) {
+	names := make([]string, 0, len(in.vars))
+	for name := range in.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "%s = %v\n", name, in.vars[name])
+	}
+}
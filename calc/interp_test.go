@@ -0,0 +1,24 @@
+package calc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBatchSeparatesErrors(t *testing.T) {
+	in := New()
+	src := "x = 2\nx * 3\ny + 1\n(1 +\nx + 0.5\n"
+	var out, errs bytes.Buffer
+	err := in.Batch("script", strings.NewReader(src), &out, &errs)
+	if err == nil || err.Error() != "script: 2 line(s) failed" {
+		t.Errorf("Batch() = %v", err)
+	}
+	if got, want := out.String(), "6\n2.5\n"; got != want {
+		t.Errorf("results = %q, want %q", got, want)
+	}
+	lines := strings.Split(strings.TrimSpace(errs.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "script:3:1: ") || !strings.HasPrefix(lines[1], "script:4:") {
+		t.Errorf("errors = %q", errs.String())
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Pakiet calc to kalkulator liniowy: każda linia to wyrażenie albo przypisanie, np.
+
+	r = 2.5
+	pole = pi * r^2
+	hypot(3, 4) + sqrt(16)
+
+Liczby całkowite (int64) i zmiennoprzecinkowe (float64) są rozróżniane jak w Go: 7/2 to 3, a 7/2.0 to 3.5.
+Parser jest parserem Pratta - każdy operator ma swoją siłę wiązania (ang. binding power),
+dzięki czemu priorytety i łączność operatorów opisuje jedna tabela zamiast osobnej funkcji na każdy poziom.
+*/
+package calc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Pos to pozycja w źródle; linie i kolumny liczone są od 1, kolumny w runach.
+type Pos struct {
+	Line, Col int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Col)
+}
+
+// Error to błąd składni lub obliczeń razem z miejscem, w którym wystąpił.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Pos.String() + ": " + e.Msg
+}
+
+func errorf(pos Pos, format string, args ...any) *Error {
+	return &Error{pos, fmt.Sprintf(format, args...)}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp // + - * / % ^ = , ( )
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  Pos
+	val  Value // dla tokNumber
+}
+
+func lex(line int, src string) ([]token, error) {
+	var tokens []token
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := Pos{line, i + 1}
+		switch {
+		case r == '#': // komentarz do końca linii
+			i = len(runes)
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			isFloat := false
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.') {
+				isFloat = isFloat || runes[i] == '.'
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					isFloat = true
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			text := string(runes[start:i])
+			val, err := parseNumber(strings.ReplaceAll(text, "_", ""), isFloat)
+			if err != nil {
+				return nil, errorf(pos, "invalid number %q: %v", text, err)
+			}
+			tokens = append(tokens, token{tokNumber, text, pos, val})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: string(runes[start:i]), pos: pos})
+		case strings.ContainsRune("+-*/%^=,()", r):
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: pos})
+			i++
+		default:
+			return nil, errorf(pos, "unexpected character %q", r)
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: Pos{line, len(runes) + 1}}), nil
+}
+
+func parseNumber(text string, isFloat bool) (Value, error) {
+	if isFloat {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return Value{}, err.(*strconv.NumError).Err
+		}
+		return Float(f), nil
+	}
+	i, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return Value{}, err.(*strconv.NumError).Err
+	}
+	return Int(i), nil
+}
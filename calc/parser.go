@@ -0,0 +1,208 @@
+package calc
+
+import "strings"
+
+type node interface {
+	position() Pos
+}
+
+type (
+	numberNode struct {
+		pos Pos
+		val Value
+	}
+	identNode struct {
+		pos  Pos
+		name string
+	}
+	unaryNode struct {
+		pos Pos
+		op  string
+		x   node
+	}
+	binaryNode struct {
+		pos  Pos // pozycja operatora
+		op   string
+		l, r node
+	}
+	assignNode struct {
+		pos  Pos
+		name string
+		x    node
+	}
+	callNode struct {
+		pos  Pos
+		name string
+		args []node
+	}
+)
+
+func (n numberNode) position() Pos { return n.pos }
+func (n identNode) position() Pos  { return n.pos }
+func (n unaryNode) position() Pos  { return n.pos }
+func (n binaryNode) position() Pos { return n.pos }
+func (n assignNode) position() Pos { return n.pos }
+func (n callNode) position() Pos   { return n.pos }
+
+/*
+Siła wiązania operatora w pozycji infiksowej. Im większa, tym mocniej operator "przyciąga" argumenty:
+
+	=        10  prawostronnie łączny: a = b = 1
+	+ -      20
+	* / %    30
+	- (unarny) 40  dlatego -2^2 to -(2^2)
+	^        50  prawostronnie łączny: 2^3^2 to 2^(3^2)
+	( wywołanie 60
+*/
+var bindingPower = map[string]int{
+	"=": 10,
+	"+": 20, "-": 20,
+	"*": 30, "/": 30, "%": 30,
+	"^": 50,
+	"(": 60,
+}
+
+const unaryPower = 40
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func parseLine(line int, src string) (node, error) {
+	tokens, err := lex(line, src)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokEOF {
+		return nil, nil // pusta linia lub sam komentarz
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(t.pos, "unexpected %q", t.text)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) lbp(t token) int {
+	if t.kind != tokOp {
+		return 0
+	}
+	return bindingPower[t.text]
+}
+
+// expr to serce parsera Pratta: parsuje przedrostek (nud), a potem dokleja operatory silniejsze niż rbp (led).
+func (p *parser) expr(rbp int) (node, error) {
+	left, err := p.nud(p.next())
+	if err != nil {
+		return nil, err
+	}
+	for rbp < p.lbp(p.peek()) {
+		if left, err = p.led(p.next(), left); err != nil {
+			return nil, err
+		}
+	}
+	return left, nil
+}
+
+// nud (ang. null denotation) obsługuje token na początku wyrażenia.
+func (p *parser) nud(t token) (node, error) {
+	switch {
+	case t.kind == tokNumber:
+		return numberNode{t.pos, t.val}, nil
+	case t.kind == tokIdent:
+		return identNode{t.pos, t.text}, nil
+	case t.text == "-" || t.text == "+":
+		x, err := p.expr(unaryPower)
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{t.pos, t.text, x}, nil
+	case t.text == "(":
+		x, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.text != ")" {
+			return nil, errorf(closing.pos, "expected \")\", found %s", describe(closing))
+		}
+		return x, nil
+	}
+	return nil, errorf(t.pos, "unexpected %s", describe(t))
+}
+
+// led (ang. left denotation) obsługuje operator stojący za już sparsowanym lewym argumentem.
+func (p *parser) led(t token, left node) (node, error) {
+	switch t.text {
+	case "=":
+		id, ok := left.(identNode)
+		if !ok {
+			return nil, errorf(t.pos, "cannot assign to expression")
+		}
+		x, err := p.expr(bindingPower["="] - 1)
+		if err != nil {
+			return nil, err
+		}
+		return assignNode{id.pos, id.name, x}, nil
+	case "(":
+		id, ok := left.(identNode)
+		if !ok {
+			return nil, errorf(t.pos, "only named functions can be called")
+		}
+		return p.call(id)
+	case "^":
+		r, err := p.expr(bindingPower["^"] - 1)
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{t.pos, t.text, left, r}, nil
+	}
+	r, err := p.expr(bindingPower[t.text])
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode{t.pos, t.text, left, r}, nil
+}
+
+func (p *parser) call(fn identNode) (node, error) {
+	n := callNode{pos: fn.pos, name: fn.name}
+	if p.peek().text == ")" {
+		p.next()
+		return n, nil
+	}
+	for {
+		arg, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		n.args = append(n.args, arg)
+		switch t := p.next(); t.text {
+		case ",":
+		case ")":
+			return n, nil
+		default:
+			return nil, errorf(t.pos, "expected \",\" or \")\" in call to %s, found %s", fn.name, describe(t))
+		}
+	}
+}
+
+func describe(t token) string {
+	if t.kind == tokEOF {
+		return "end of line"
+	}
+	return "\"" + strings.TrimSpace(t.text) + "\""
+}
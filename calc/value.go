@@ -0,0 +1,140 @@
+package calc
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+/*
+Value to liczba całkowita albo zmiennoprzecinkowa. Działania na dwóch liczbach całkowitych dają liczbę całkowitą
+(dzielenie obcina w stronę zera), a jeśli choć jeden argument jest float, wynik też jest float - jak w Go
+po jawnej konwersji. Przepełnienie int64 jest błędem, a nie cichym "zawinięciem".
+*/
+type Value struct {
+	isFloat bool
+	i       int64
+	f       float64
+}
+
+func Int(i int64) Value     { return Value{i: i} }
+func Float(f float64) Value { return Value{isFloat: true, f: f} }
+
+// IsInt mówi, czy wartość jest liczbą całkowitą.
+func (v Value) IsInt() bool { return !v.isFloat }
+
+// Int64 zwraca wartość całkowitą; dla float obcina część ułamkową.
+func (v Value) Int64() int64 {
+	if v.isFloat {
+		return int64(v.f)
+	}
+	return v.i
+}
+
+// Float64 zwraca wartość jako float64.
+func (v Value) Float64() float64 {
+	if v.isFloat {
+		return v.f
+	}
+	return float64(v.i)
+}
+
+func (v Value) String() string {
+	if v.isFloat {
+		return strconv.FormatFloat(v.f, 'g', -1, 64)
+	}
+	return strconv.FormatInt(v.i, 10)
+}
+
+var (
+	ErrDivisionByZero = errors.New("division by zero")
+	ErrOverflow       = errors.New("integer overflow")
+)
+
+func binary(op string, a, b Value) (Value, error) {
+	if a.isFloat || b.isFloat {
+		x, y := a.Float64(), b.Float64()
+		switch op {
+		case "+":
+			return Float(x + y), nil
+		case "-":
+			return Float(x - y), nil
+		case "*":
+			return Float(x * y), nil
+		case "/":
+			return Float(x / y), nil
+		case "%":
+			return Float(math.Mod(x, y)), nil
+		case "^":
+			return Float(math.Pow(x, y)), nil
+		}
+		return Value{}, errors.New("unknown operator " + op)
+	}
+
+	x, y := a.i, b.i
+	switch op {
+	case "+":
+		r := x + y
+		if (x > 0 && y > 0 && r < 0) || (x < 0 && y < 0 && r >= 0) {
+			return Value{}, ErrOverflow
+		}
+		return Int(r), nil
+	case "-":
+		r := x - y
+		if (x >= 0 && y < 0 && r < 0) || (x < 0 && y > 0 && r >= 0) {
+			return Value{}, ErrOverflow
+		}
+		return Int(r), nil
+	case "*":
+		r := x * y
+		if x != 0 && (r/x != y || (x == -1 && y == math.MinInt64)) {
+			return Value{}, ErrOverflow
+		}
+		return Int(r), nil
+	case "/", "%":
+		if y == 0 {
+			return Value{}, ErrDivisionByZero
+		}
+		if x == math.MinInt64 && y == -1 {
+			if op == "%" {
+				return Int(0), nil
+			}
+			return Value{}, ErrOverflow
+		}
+		if op == "/" {
+			return Int(x / y), nil
+		}
+		return Int(x % y), nil
+	case "^":
+		// Ujemny wykładnik daje ułamek, więc liczymy go już na float64.
+		if y < 0 {
+			return Float(math.Pow(float64(x), float64(y))), nil
+		}
+		r := Int(1)
+		for base := a; y > 0; y >>= 1 {
+			var err error
+			if y&1 == 1 {
+				if r, err = binary("*", r, base); err != nil {
+					return Value{}, err
+				}
+			}
+			if y > 1 {
+				if base, err = binary("*", base, base); err != nil {
+					return Value{}, err
+				}
+			}
+		}
+		return r, nil
+	}
+	return Value{}, errors.New("unknown operator " + op)
+}
+
+func negate(v Value) (Value, error) {
+	if v.isFloat {
+		return Float(-v.f), nil
+	}
+	if v.i == math.MinInt64 {
+		return Value{}, ErrOverflow
+	}
+	return Int(-v.i), nil
+}
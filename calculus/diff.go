@@ -0,0 +1,42 @@
+package calculus
+
+import "math"
+
+// Domyślny krok dla ilorazów różnicowych, dobrany pod dokładność float64.
+func defaultStep(x float64) float64 {
+	return 1e-4 * math.Max(1, math.Abs(x))
+}
+
+// Forward to iloraz różnicowy w przód (f(x+h) - f(x)) / h, rząd 1.
+func Forward(f Func, x, h float64) Estimate {
+	if h == 0 {
+		h = defaultStep(x)
+	}
+	d := func(h float64) float64 { return (f(x+h) - f(x)) / h }
+	fine, coarse := d(h/2), d(h)
+	return Estimate{Value: 2*fine - coarse, Err: math.Abs(fine - coarse), Evals: 4}
+}
+
+/*
+Central to iloraz różnicowy centralny (f(x+h) - f(x-h)) / 2h, rząd 2.
+Wynik jest poprawiany ekstrapolacją Richardsona, co daje rząd 4.
+*/
+func Central(f Func, x, h float64) Estimate {
+	if h == 0 {
+		h = defaultStep(x)
+	}
+	d := func(h float64) float64 { return (f(x+h) - f(x-h)) / (2 * h) }
+	fine, coarse := d(h/2), d(h)
+	return Estimate{Value: (4*fine - coarse) / 3, Err: math.Abs(fine-coarse) / 3, Evals: 4}
+}
+
+// Second liczy drugą pochodną (f(x+h) - 2f(x) + f(x-h)) / h².
+func Second(f Func, x, h float64) Estimate {
+	if h == 0 {
+		h = 10 * defaultStep(x)
+	}
+	fx := f(x)
+	d := func(h float64) float64 { return (f(x+h) - 2*fx + f(x-h)) / (h * h) }
+	fine, coarse := d(h/2), d(h)
+	return Estimate{Value: (4*fine - coarse) / 3, Err: math.Abs(fine-coarse) / 3, Evals: 5}
+}
@@ -0,0 +1,30 @@
+package calculus
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDerivatives(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(f Func, x, h float64) Estimate
+		f    Func
+		x    float64
+		want float64
+		tol  float64
+	}{
+		{"Forward sin", Forward, math.Sin, 1, math.Cos(1), 1e-7},
+		{"Central sin", Central, math.Sin, 1, math.Cos(1), 1e-10},
+		{"Central exp", Central, math.Exp, 2, math.Exp(2), 1e-9},
+		{"Central large x", Central, math.Log, 1e6, 1e-6, 1e-15},
+		{"Second sin", Second, math.Sin, 1, -math.Sin(1), 1e-7},
+		{"Second cubic", Second, func(x float64) float64 { return x * x * x }, 2, 12, 1e-6},
+	}
+	for _, tt := range tests {
+		got := tt.fn(tt.f, tt.x, 0)
+		if math.Abs(got.Value-tt.want) > tt.tol {
+			t.Errorf("%s at %v = %v, want %v ± %g", tt.name, tt.x, got.Value, tt.want, tt.tol)
+		}
+	}
+}
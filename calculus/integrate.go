@@ -0,0 +1,154 @@
+/*
+Pakiet calculus zawiera metody numeryczne analizy: całkowanie, różniczkowanie i rozwiązywanie
+równań różniczkowych zwyczajnych. Wszystkie przyjmują funkcje jako wartości (ang. function values),
+tak jak compute w lekcji o strukturach.
+
+Każda metoda zwraca oprócz wyniku oszacowanie błędu, zwykle liczone ekstrapolacją Richardsona:
+porównujemy wynik dla kroku h i h/2, a znając rząd metody p, błąd ≈ |R(h/2) - R(h)| / (2^p - 1).
+*/
+package calculus
+
+import (
+	"errors"
+	"math"
+)
+
+type Func func(x float64) float64
+
+// Estimate to przybliżony wynik wraz z oszacowaniem błędu i liczbą wywołań funkcji.
+type Estimate struct {
+	Value float64
+	Err   float64
+	Evals int
+}
+
+var ErrInvalidN = errors.New("calculus: invalid number of subintervals")
+
+func trapezoid(f Func, a, b float64, n int) float64 {
+	h := (b - a) / float64(n)
+	sum := (f(a) + f(b)) / 2
+	for i := 1; i < n; i++ {
+		sum += f(a + float64(i)*h)
+	}
+	return sum * h
+}
+
+/*
+Trapezoid całkuje metodą trapezów na n podprzedziałach (rząd 2). n musi być parzyste: błąd szacujemy,
+porównując wynik z siatką o dwa razy dłuższym kroku, czyli n/2 podprzedziałami.
+*/
+func Trapezoid(f Func, a, b float64, n int) (Estimate, error) {
+	if n < 2 || n%2 != 0 {
+		return Estimate{}, ErrInvalidN
+	}
+	fine := trapezoid(f, a, b, n)
+	coarse := trapezoid(f, a, b, n/2)
+	return Estimate{Value: fine, Err: math.Abs(fine-coarse) / 3, Evals: n + 1 + n/2 + 1}, nil
+}
+
+func simpson(f Func, a, b float64, n int) float64 {
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+	for i := 1; i < n; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4
+		}
+		sum += w * f(a+float64(i)*h)
+	}
+	return sum * h / 3
+}
+
+// Simpson całkuje złożoną metodą Simpsona (rząd 4). n musi być parzyste i podzielne przez 4, żeby oszacować błąd.
+func Simpson(f Func, a, b float64, n int) (Estimate, error) {
+	if n < 4 || n%4 != 0 {
+		return Estimate{}, ErrInvalidN
+	}
+	fine := simpson(f, a, b, n)
+	coarse := simpson(f, a, b, n/2)
+	return Estimate{Value: fine, Err: math.Abs(fine-coarse) / 15, Evals: n + 1 + n/2 + 1}, nil
+}
+
+/*
+AdaptiveSimpson dzieli przedział tylko tam, gdzie funkcja jest "trudna":
+jeśli Simpson na całym przedziale i na dwóch połówkach daje podobny wynik, kończymy,
+w przeciwnym razie rekurencyjnie dzielimy obie połówki z połową tolerancji.
+*/
+func AdaptiveSimpson(f Func, a, b, tol float64, maxDepth int) Estimate {
+	fa, fm, fb := f(a), f((a+b)/2), f(b)
+	est := Estimate{Evals: 3}
+	whole := (b - a) / 6 * (fa + 4*fm + fb)
+	est.Value = adaptive(f, a, b, fa, fm, fb, whole, tol, maxDepth, &est)
+	return est
+}
+
+func adaptive(f Func, a, b, fa, fm, fb, whole, tol float64, depth int, est *Estimate) float64 {
+	m := (a + b) / 2
+	lm, rm := (a+m)/2, (m+b)/2
+	flm, frm := f(lm), f(rm)
+	est.Evals += 2
+
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+	diff := left + right - whole
+
+	if depth <= 0 || math.Abs(diff) <= 15*tol {
+		est.Err += math.Abs(diff) / 15
+		return left + right + diff/15
+	}
+	return adaptive(f, a, m, fa, flm, fm, left, tol/2, depth-1, est) +
+		adaptive(f, m, b, fm, frm, fb, right, tol/2, depth-1, est)
+}
+
+/*
+gaussNodes wylicza węzły i wagi kwadratury Gaussa-Legendre'a rzędu n.
+Węzły to miejsca zerowe wielomianu Legendre'a Pₙ, szukane metodą Newtona od przybliżenia cos(π(i-¼)/(n+½)).
+*/
+func gaussNodes(n int) (nodes, weights []float64) {
+	nodes = make([]float64, n)
+	weights = make([]float64, n)
+	for i := range (n + 1) / 2 {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var dp float64
+		for range 100 {
+			// Rekurencja Bonneta: (k+1)P_{k+1} = (2k+1)xP_k - kP_{k-1}.
+			p0, p1 := 1.0, x
+			for k := 2; k <= n; k++ {
+				p0, p1 = p1, ((2*float64(k)-1)*x*p1-(float64(k)-1)*p0)/float64(k)
+			}
+			dp = float64(n) * (x*p1 - p0) / (x*x - 1)
+			dx := p1 / dp
+			x -= dx
+			if math.Abs(dx) < 1e-15 {
+				break
+			}
+		}
+		w := 2 / ((1 - x*x) * dp * dp)
+		nodes[i], nodes[n-1-i] = -x, x
+		weights[i], weights[n-1-i] = w, w
+	}
+	return nodes, weights
+}
+
+func gauss(f Func, a, b float64, n int) float64 {
+	nodes, weights := gaussNodes(n)
+	half, mid := (b-a)/2, (a+b)/2
+	var sum float64
+	for i, x := range nodes {
+		sum += weights[i] * f(mid+half*x)
+	}
+	return sum * half
+}
+
+/*
+GaussLegendre całkuje kwadraturą Gaussa z n węzłami; jest dokładna dla wielomianów stopnia do 2n-1.
+Błąd szacujemy, porównując wynik z kwadraturą o n+1 węzłach.
+*/
+func GaussLegendre(f Func, a, b float64, n int) (Estimate, error) {
+	if n < 1 {
+		return Estimate{}, ErrInvalidN
+	}
+	v := gauss(f, a, b, n)
+	better := gauss(f, a, b, n+1)
+	return Estimate{Value: v, Err: math.Abs(better - v), Evals: 2*n + 1}, nil
+}
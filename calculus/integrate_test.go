@@ -0,0 +1,89 @@
+package calculus
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+var integrals = []struct {
+	name string
+	f    Func
+	a, b float64
+	want float64
+}{
+	{"sin", math.Sin, 0, math.Pi, 2},
+	{"exp", math.Exp, 0, 1, math.E - 1},
+	{"cubic", func(x float64) float64 { return x*x*x - 2*x }, -1, 2, 0.75},
+	{"1/x", func(x float64) float64 { return 1 / x }, 1, 10, math.Log(10)},
+}
+
+// TestIntegrate sprawdza wynik i to, że oszacowanie błędu ma ten sam rząd wielkości co błąd rzeczywisty.
+func TestIntegrate(t *testing.T) {
+	methods := []struct {
+		name string
+		fn   func(f Func, a, b float64) (Estimate, error)
+		tol  float64
+	}{
+		{"Trapezoid", func(f Func, a, b float64) (Estimate, error) { return Trapezoid(f, a, b, 1000) }, 1e-4},
+		{"Simpson", func(f Func, a, b float64) (Estimate, error) { return Simpson(f, a, b, 100) }, 1e-5},
+		{"GaussLegendre", func(f Func, a, b float64) (Estimate, error) { return GaussLegendre(f, a, b, 10) }, 1e-5},
+		{"AdaptiveSimpson", func(f Func, a, b float64) (Estimate, error) { return AdaptiveSimpson(f, a, b, 1e-9, 50), nil }, 1e-8},
+	}
+	for _, m := range methods {
+		for _, in := range integrals {
+			est, err := m.fn(in.f, in.a, in.b)
+			if err != nil {
+				t.Errorf("%s(%s): %v", m.name, in.name, err)
+				continue
+			}
+			actual := math.Abs(est.Value - in.want)
+			if actual > m.tol {
+				t.Errorf("%s(%s) = %v, want %v ± %g", m.name, in.name, est.Value, in.want, m.tol)
+			}
+			if actual > 1e-12 && (est.Err < actual/10 || est.Err > actual*10) {
+				t.Errorf("%s(%s): Err = %g, actual error %g", m.name, in.name, est.Err, actual)
+			}
+		}
+	}
+}
+
+// TestInvalidN sprawdza liczby podprzedziałów; nieparzyste n w Trapezoid jest odrzucane, bo siatka n/2 nie byłaby o połowę rzadsza.
+func TestInvalidN(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(n int) (Estimate, error)
+		ok   []int
+		bad  []int
+	}{
+		{"Trapezoid", func(n int) (Estimate, error) { return Trapezoid(math.Exp, 0, 1, n) }, []int{2, 4, 10}, []int{-2, 0, 1, 3, 11}},
+		{"Simpson", func(n int) (Estimate, error) { return Simpson(math.Exp, 0, 1, n) }, []int{4, 8, 12}, []int{0, 2, 6, 10}},
+		{"GaussLegendre", func(n int) (Estimate, error) { return GaussLegendre(math.Exp, 0, 1, n) }, []int{1, 2, 7}, []int{0, -1}},
+	}
+	for _, tt := range tests {
+		for _, n := range tt.ok {
+			if _, err := tt.fn(n); err != nil {
+				t.Errorf("%s n=%d: %v", tt.name, n, err)
+			}
+		}
+		for _, n := range tt.bad {
+			if _, err := tt.fn(n); !errors.Is(err, ErrInvalidN) {
+				t.Errorf("%s n=%d: error = %v, want ErrInvalidN", tt.name, n, err)
+			}
+		}
+	}
+}
+
+// TestGaussExact sprawdza, że n węzłów całkuje dokładnie wielomiany stopnia do 2n-1.
+func TestGaussExact(t *testing.T) {
+	for n := 1; n <= 8; n++ {
+		deg := 2*n - 1
+		est, err := GaussLegendre(func(x float64) float64 { return math.Pow(x, float64(deg)) + 1 }, 0, 1, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := 1/float64(deg+1) + 1; math.Abs(est.Value-want) > 1e-13 {
+			t.Errorf("n=%d: ∫x^%d+1 = %v, want %v", n, deg, est.Value, want)
+		}
+	}
+}
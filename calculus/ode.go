@@ -0,0 +1,64 @@
+package calculus
+
+import "math"
+
+/*
+ODEFunc opisuje równanie różniczkowe y' = f(t, y).
+To ta sama sygnatura func(float64, float64) float64, którą przyjmuje compute w lekcji,
+więc np. hypot albo math.Pow też mogą być prawą stroną równania.
+*/
+type ODEFunc func(t, y float64) float64
+
+// Solution zawiera kolejne punkty (T[i], Y[i]) przybliżonego rozwiązania.
+type Solution struct {
+	T, Y []float64
+	// Err szacuje błąd wartości końcowej metodą podwojenia liczby kroków.
+	Err float64
+}
+
+func (s Solution) Final() float64 { return s.Y[len(s.Y)-1] }
+
+type stepper func(f ODEFunc, t, y, h float64) float64
+
+func eulerStep(f ODEFunc, t, y, h float64) float64 {
+	return y + h*f(t, y)
+}
+
+func rk4Step(f ODEFunc, t, y, h float64) float64 {
+	k1 := f(t, y)
+	k2 := f(t+h/2, y+h/2*k1)
+	k3 := f(t+h/2, y+h/2*k2)
+	k4 := f(t+h, y+h*k3)
+	return y + h/6*(k1+2*k2+2*k3+k4)
+}
+
+func integrate(step stepper, f ODEFunc, t0, y0, t1 float64, n int) Solution {
+	h := (t1 - t0) / float64(n)
+	sol := Solution{T: make([]float64, n+1), Y: make([]float64, n+1)}
+	sol.T[0], sol.Y[0] = t0, y0
+	for i := range n {
+		sol.Y[i+1] = step(f, sol.T[i], sol.Y[i], h)
+		sol.T[i+1] = t0 + float64(i+1)*h
+	}
+	return sol
+}
+
+func solve(step stepper, order int, f ODEFunc, t0, y0, t1 float64, n int) Solution {
+	if n < 1 {
+		n = 1
+	}
+	sol := integrate(step, f, t0, y0, t1, n)
+	fine := integrate(step, f, t0, y0, t1, 2*n)
+	sol.Err = math.Abs(fine.Final()-sol.Final()) / (math.Pow(2, float64(order)) - 1) * math.Pow(2, float64(order))
+	return sol
+}
+
+// Euler rozwiązuje y' = f(t, y), y(t0) = y0 na [t0, t1] metodą Eulera w n krokach (rząd 1).
+func Euler(f ODEFunc, t0, y0, t1 float64, n int) Solution {
+	return solve(eulerStep, 1, f, t0, y0, t1, n)
+}
+
+// RK4 rozwiązuje to samo równanie klasyczną metodą Rungego-Kutty 4. rzędu.
+func RK4(f ODEFunc, t0, y0, t1 float64, n int) Solution {
+	return solve(rk4Step, 4, f, t0, y0, t1, n)
+}
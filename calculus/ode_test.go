@@ -0,0 +1,33 @@
+package calculus
+
+import (
+	"math"
+	"testing"
+)
+
+// TestODE rozwiązuje y' = y, y(0) = 1, którego dokładne rozwiązanie to e^t.
+func TestODE(t *testing.T) {
+	f := func(t, y float64) float64 { return y }
+	tests := []struct {
+		name  string
+		solve func(f ODEFunc, t0, y0, t1 float64, n int) Solution
+		n     int
+		tol   float64
+	}{
+		{"Euler", Euler, 1000, 2e-3},
+		{"RK4", RK4, 20, 1e-6},
+	}
+	for _, tt := range tests {
+		sol := tt.solve(f, 0, 1, 1, tt.n)
+		if len(sol.T) != tt.n+1 || sol.T[0] != 0 || math.Abs(sol.T[tt.n]-1) > 1e-12 {
+			t.Errorf("%s: T = %v...%v (%d points)", tt.name, sol.T[0], sol.T[len(sol.T)-1], len(sol.T))
+		}
+		actual := math.Abs(sol.Final() - math.E)
+		if actual > tt.tol {
+			t.Errorf("%s: y(1) = %v, want e ± %g", tt.name, sol.Final(), tt.tol)
+		}
+		if sol.Err < actual/10 || sol.Err > actual*10 {
+			t.Errorf("%s: Err = %g, actual error %g", tt.name, sol.Err, actual)
+		}
+	}
+}
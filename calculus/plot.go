@@ -0,0 +1,77 @@
+package calculus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+/*
+Plot rysuje wykres funkcji f na przedziale [a, b] znakami ASCII w prostokącie width×height.
+Jeśli oś X (y = 0) lub oś Y (x = 0) mieści się w zakresie, jest rysowana odpowiednio znakami '-' i '|'.
+Wartości NaN i nieskończone są pomijane.
+*/
+func Plot(w io.Writer, f Func, a, b float64, width, height int) error {
+	if width < 2 || height < 2 || !(b > a) {
+		return errors.New("calculus: invalid plot size or interval")
+	}
+
+	ys := make([]float64, width)
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i := range ys {
+		x := a + (b-a)*float64(i)/float64(width-1)
+		ys[i] = f(x)
+		if !math.IsNaN(ys[i]) && !math.IsInf(ys[i], 0) {
+			lo, hi = math.Min(lo, ys[i]), math.Max(hi, ys[i])
+		}
+	}
+	if math.IsInf(lo, 1) {
+		return errors.New("calculus: function has no finite values on the interval")
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+
+	row := func(y float64) int {
+		return int(math.Round((hi - y) / (hi - lo) * float64(height-1)))
+	}
+
+	grid := make([][]byte, height)
+	for r := range grid {
+		grid[r] = []byte(strings.Repeat(" ", width))
+	}
+	if lo <= 0 && hi >= 0 {
+		for c := range width {
+			grid[row(0)][c] = '-'
+		}
+	}
+	if a <= 0 && b >= 0 {
+		col := int(math.Round(-a / (b - a) * float64(width-1)))
+		for r := range grid {
+			grid[r][col] = '|'
+		}
+	}
+	for c, y := range ys {
+		if !math.IsNaN(y) && !math.IsInf(y, 0) {
+			grid[row(y)][c] = '*'
+		}
+	}
+
+	label := max(len(fmt.Sprintf("%.3g", hi)), len(fmt.Sprintf("%.3g", lo)))
+	for r, line := range grid {
+		prefix := ""
+		switch r {
+		case 0:
+			prefix = fmt.Sprintf("%.3g", hi)
+		case height - 1:
+			prefix = fmt.Sprintf("%.3g", lo)
+		}
+		if _, err := fmt.Fprintf(w, "%*s ┤%s\n", label, prefix, line); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%*s  %-*.3g%*.3g\n", label, "", width/2, a, width-width/2, b)
+	return err
+}
@@ -0,0 +1,58 @@
+/*
+Bigcalc liczy wyrażenia jednocześnie w int64, float64 i typach z math/big, pokazując różnice.
+
+	go run ./cmd/bigcalc '12676506002282295 * 0.1'
+	go run ./cmd/bigcalc -prec=64 '2^70 + 1' '1/3*3'
+	go run ./cmd/bigcalc                 # tryb interaktywny, jedno wyrażenie na linię
+
+Wiersze oznaczone "!!" straciły informację: int64 się przepełnił albo wynik został zaokrąglony.
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"lets-go/bigcalc"
+	"os"
+	"strings"
+)
+
+func main() {
+	prec := flag.Uint("prec", bigcalc.DefaultPrec, "precyzja big.Float w bitach mantysy")
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		failed := false
+		for _, expr := range flag.Args() {
+			if !run(expr, *prec) {
+				failed = true
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+		return
+	}
+
+	in := bufio.NewScanner(os.Stdin)
+	fmt.Print("> ")
+	for in.Scan() {
+		if expr := strings.TrimSpace(in.Text()); expr != "" {
+			run(expr, *prec)
+		}
+		fmt.Print("> ")
+	}
+	fmt.Println()
+}
+
+func run(expr string, prec uint) bool {
+	rep, err := bigcalc.Compare(expr, prec)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "bigcalc:", err)
+		return false
+	}
+	rep.Write(os.Stdout)
+	fmt.Println()
+	return true
+}
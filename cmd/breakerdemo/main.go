@@ -0,0 +1,78 @@
+/*
+Breakerdemo pokazuje działanie wyłącznika obwodu na przykładzie niestabilnej usługi.
+
+Usługa działa poprawnie, potem przez pewien czas prawie zawsze zwraca błąd, a na koniec znów działa.
+Zegar jest symulowany (każde żądanie to 200ms), więc program kończy się od razu.
+*/
+package main
+
+import (
+	"errors"
+	"fmt"
+	"lets-go/basics"
+	"lets-go/breaker"
+	"math/rand/v2"
+	"time"
+)
+
+var errUnavailable = errors.New("service unavailable")
+
+// flakyService symuluje usługę, która w przedziale [outageFrom, outageTo) zawodzi w 90% przypadków.
+type flakyService struct {
+	rng                  *rand.Rand
+	now                  func() time.Time
+	outageFrom, outageTo time.Time
+	calls                int
+}
+
+func (s *flakyService) Call() error {
+	s.calls++
+	now := s.now()
+	if !now.Before(s.outageFrom) && now.Before(s.outageTo) && s.rng.Float64() < 0.9 {
+		return errUnavailable
+	}
+	return nil
+}
+
+func main() {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+
+	svc := &flakyService{
+		rng:        rand.New(rand.NewPCG(1, 2)),
+		now:        clock,
+		outageFrom: start.Add(10 * time.Second),
+		outageTo:   start.Add(25 * time.Second),
+	}
+
+	cb := breaker.New(breaker.Settings{
+		Window:         5 * time.Second,
+		FailureRate:    0.5,
+		MinRequests:    5,
+		Cooldown:       3 * time.Second,
+		HalfOpenProbes: 2,
+		Now:            clock,
+		OnStateChange: func(from, to basics.ServerState) {
+			fmt.Printf("%6s  state %s -> %s\n", now.Sub(start), from, to)
+		},
+	})
+
+	results := map[string]int{}
+	for range 200 {
+		err := cb.Execute(svc.Call)
+		switch {
+		case err == nil:
+			results["ok"]++
+		case errors.Is(err, breaker.ErrOpen), errors.Is(err, breaker.ErrTooManyProbes):
+			results["rejected"]++
+		default:
+			results["failed"]++
+		}
+		now = now.Add(200 * time.Millisecond)
+	}
+
+	fmt.Printf("requests: %d, reached service: %d, ok: %d, failed: %d, rejected by breaker: %d\n",
+		200, svc.calls, results["ok"], results["failed"], results["rejected"])
+	fmt.Println("final state:", cb.State())
+}
@@ -0,0 +1,46 @@
+/*
+Calc to kalkulator wyrażeń z pakietu calc: zmienne, przypisania, funkcje sqrt, pow, hypot, abs, min i max.
+
+	go run ./cmd/calc                 # tryb interaktywny (REPL)
+	go run ./cmd/calc -f wzory.txt    # tryb wsadowy: wyniki na stdout, błędy jako plik:linia:kolumna na stderr
+	echo 'hypot(3, 4)' | go run ./cmd/calc -f -
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"lets-go/basics"
+	"os"
+)
+
+func main() {
+	file := flag.String("f", "", "plik z wyrażeniami, jedno na linię (\"-\" to standardowe wejście)")
+	flag.Parse()
+
+	in := basics.NewCalculator()
+	if *file == "" {
+		if err := in.REPL(os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "calc:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var r io.Reader = os.Stdin
+	name := "stdin"
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "calc:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		r, name = f, *file
+	}
+	if err := in.Batch(name, r, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "calc:", err)
+		os.Exit(1)
+	}
+}
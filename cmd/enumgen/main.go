@@ -0,0 +1,404 @@
+/*
+Enumgen jest generatorem kodu dla typów wyliczeniowych opartych o iota.
+
+Wywoływany przez `go generate` odnajduje w pakiecie bloki const, w których pierwsza stała
+ma jawnie podany typ oraz wartość zawierającą iota, np.:
+
+	//go:generate go run lets-go/cmd/enumgen -type=ServerState
+
+	const (
+		StateIdle ServerState = iota // idle
+		StateConnected               // connected
+	)
+
+Nazwa tekstowa każdej wartości pochodzi z komentarza na końcu linii.
+Jeśli któraś stała nie ma nazwy, lub dwie stałe mają tę samą nazwę, generator kończy się błędem,
+dzięki czemu nie da się dodać nowej wartości i zapomnieć o jej reprezentacji tekstowej.
+
+Dla każdego typu generowane są: String(), GoString(), Parse<Typ>(string), <Typ>Values(), IsValid()
+oraz metody MarshalText/UnmarshalText i MarshalJSON/UnmarshalJSON. GoString (%#v) zwraca nazwę stałej w kodzie,
+np. basics.StateIdle, więc nie trzeba utrzymywać jej ręcznie obok nazw tekstowych.
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+
+	types := flag.String("type", "", "lista typów oddzielona przecinkami; domyślnie wszystkie typy z blokami iota")
+	dir := flag.String("dir", ".", "katalog pakietu")
+	flag.Parse()
+
+	var wanted []string
+	if *types != "" {
+		wanted = strings.Split(*types, ",")
+	}
+
+	if err := run(*dir, wanted); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(dir string, wanted []string) error {
+	pkgName, enums, err := parseDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range wanted {
+		if _, ok := enums[name]; !ok {
+			return fmt.Errorf("nie znaleziono bloku iota dla typu %s", name)
+		}
+	}
+	if wanted == nil {
+		for name := range enums {
+			wanted = append(wanted, name)
+		}
+		sort.Strings(wanted)
+	}
+
+	for _, name := range wanted {
+		e := enums[name]
+		if err := e.validate(); err != nil {
+			return err
+		}
+
+		src, err := generate(pkgName, e)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		out := filepath.Join(dir, strings.ToLower(name)+"_enum.go")
+		if err := os.WriteFile(out, src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pojedyncza wartość typu wyliczeniowego.
+type enumValue struct {
+	ident string
+	name  string
+	value int64
+	pos   token.Position
+}
+
+type enum struct {
+	typeName string
+	values   []enumValue
+}
+
+// Sprawdza, czy każda wartość ma nazwę oraz czy nazwy i wartości się nie powtarzają.
+func (e *enum) validate() error {
+	seen := make(map[string]string)
+	values := make(map[int64]string)
+	for _, v := range e.values {
+		if other, ok := values[v.value]; ok {
+			return fmt.Errorf("%s: stała %s ma tę samą wartość %d co %s", v.pos, v.ident, v.value, other)
+		}
+		values[v.value] = v.ident
+
+		if v.name == "" {
+			return fmt.Errorf("%s: stała %s typu %s nie ma nazwy (dodaj komentarz na końcu linii, np. `%s // nazwa`)",
+				v.pos, v.ident, e.typeName, v.ident)
+		}
+		if other, ok := seen[v.name]; ok {
+			return fmt.Errorf("%s: stała %s ma tę samą nazwę %q co %s", v.pos, v.ident, v.name, other)
+		}
+		seen[v.name] = v.ident
+	}
+	return nil
+}
+
+func parseDir(dir string) (string, map[string]*enum, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fset := token.NewFileSet()
+	var pkgName string
+	enums := make(map[string]*enum)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_enum.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return "", nil, err
+		}
+		if pkgName != "" && pkgName != file.Name.Name {
+			return "", nil, fmt.Errorf("w katalogu %s znaleziono pakiety %s i %s", dir, pkgName, file.Name.Name)
+		}
+		pkgName = file.Name.Name
+
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			e, err := parseConstBlock(fset, gd)
+			if err != nil {
+				return "", nil, err
+			}
+			if e == nil {
+				continue
+			}
+			if _, dup := enums[e.typeName]; dup {
+				return "", nil, fmt.Errorf("typ %s ma więcej niż jeden blok iota", e.typeName)
+			}
+			enums[e.typeName] = e
+		}
+	}
+	if pkgName == "" {
+		return "", nil, fmt.Errorf("brak plików Go w %s", dir)
+	}
+	return pkgName, enums, nil
+}
+
+/*
+Blok const jest traktowany jako typ wyliczeniowy, gdy jego pierwsza specyfikacja ma jawny typ
+i wyrażenie zawierające iota. Kolejne specyfikacje bez wyrażenia powtarzają poprzednie, tak jak robi to kompilator.
+*/
+func parseConstBlock(fset *token.FileSet, gd *ast.GenDecl) (*enum, error) {
+	if len(gd.Specs) == 0 {
+		return nil, nil
+	}
+	first := gd.Specs[0].(*ast.ValueSpec)
+	typ, ok := first.Type.(*ast.Ident)
+	if !ok || len(first.Values) != 1 || !usesIota(first.Values[0]) {
+		return nil, nil
+	}
+
+	e := &enum{typeName: typ.Name}
+	var expr ast.Expr
+	for iota, spec := range gd.Specs {
+		vs := spec.(*ast.ValueSpec)
+		if vs.Type != nil {
+			if t, ok := vs.Type.(*ast.Ident); !ok || t.Name != e.typeName {
+				return nil, fmt.Errorf("%s: blok typu %s zawiera stałą innego typu", fset.Position(vs.Pos()), e.typeName)
+			}
+		}
+		if len(vs.Names) != 1 {
+			return nil, fmt.Errorf("%s: oczekiwano jednej stałej w linii", fset.Position(vs.Pos()))
+		}
+		if len(vs.Values) == 1 {
+			expr = vs.Values[0]
+		}
+
+		value, err := eval(expr, int64(iota))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fset.Position(vs.Pos()), err)
+		}
+
+		ident := vs.Names[0].Name
+		if ident == "_" {
+			continue
+		}
+		e.values = append(e.values, enumValue{
+			ident: ident,
+			name:  lineName(vs.Comment),
+			value: value,
+			pos:   fset.Position(vs.Pos()),
+		})
+	}
+	return e, nil
+}
+
+// Nazwą wartości jest pierwsze słowo komentarza na końcu linii.
+func lineName(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+	fields := strings.Fields(cg.Text())
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+func usesIota(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "iota" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// Oblicza proste wyrażenia całkowite z iota, np. iota + 1 lub 1 << iota.
+func eval(expr ast.Expr, iota int64) (int64, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.Name == "iota" {
+			return iota, nil
+		}
+		return 0, fmt.Errorf("nieobsługiwany identyfikator %s", e.Name)
+	case *ast.BasicLit:
+		if e.Kind != token.INT {
+			return 0, fmt.Errorf("nieobsługiwany literał %s", e.Value)
+		}
+		return strconv.ParseInt(e.Value, 0, 64)
+	case *ast.ParenExpr:
+		return eval(e.X, iota)
+	case *ast.UnaryExpr:
+		x, err := eval(e.X, iota)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.SUB:
+			return -x, nil
+		case token.ADD:
+			return x, nil
+		case token.XOR:
+			return ^x, nil
+		}
+	case *ast.BinaryExpr:
+		x, err := eval(e.X, iota)
+		if err != nil {
+			return 0, err
+		}
+		y, err := eval(e.Y, iota)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x + y, nil
+		case token.SUB:
+			return x - y, nil
+		case token.MUL:
+			return x * y, nil
+		case token.QUO:
+			if y == 0 {
+				return 0, fmt.Errorf("dzielenie przez zero")
+			}
+			return x / y, nil
+		case token.REM:
+			if y == 0 {
+				return 0, fmt.Errorf("dzielenie przez zero")
+			}
+			return x % y, nil
+		case token.SHL:
+			return x << y, nil
+		case token.SHR:
+			return x >> y, nil
+		case token.OR:
+			return x | y, nil
+		case token.AND:
+			return x & y, nil
+		case token.XOR:
+			return x ^ y, nil
+		}
+	}
+	return 0, fmt.Errorf("nieobsługiwane wyrażenie w bloku iota")
+}
+
+func generate(pkgName string, e *enum) ([]byte, error) {
+	t := e.typeName
+	parse := "Parse" + t
+	if !isExported(t) {
+		parse = "parse" + upperFirst(t)
+	}
+	values := t + "Values"
+	names := "_" + t + "Names"
+	byName := "_" + t + "ByName"
+
+	var b bytes.Buffer
+	p := func(format string, args ...any) { fmt.Fprintf(&b, format, args...) }
+
+	p("// Code generated by enumgen; DO NOT EDIT.\n\n")
+	p("package %s\n\n", pkgName)
+	p("import (\n\t\"encoding/json\"\n\t\"fmt\"\n\t\"strconv\"\n)\n\n")
+
+	p("var %s = map[%s]string{\n", names, t)
+	for _, v := range e.values {
+		p("\t%s: %q,\n", v.ident, v.name)
+	}
+	p("}\n\n")
+
+	p("var %s = map[string]%s{\n", byName, t)
+	for _, v := range e.values {
+		p("\t%q: %s,\n", v.name, v.ident)
+	}
+	p("}\n\n")
+
+	p("// String zwraca nazwę wartości lub %s(n) dla wartości spoza wyliczenia.\n", t)
+	p("func (i %s) String() string {\n", t)
+	p("\tif name, ok := %s[i]; ok {\n\t\treturn name\n\t}\n", names)
+	p("\treturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n}\n\n", t)
+
+	p("// GoString zwraca nazwę stałej z nazwą pakietu lub %s.%s(n) dla wartości spoza wyliczenia.\n", pkgName, t)
+	p("func (i %s) GoString() string {\n\tswitch i {\n", t)
+	for _, v := range e.values {
+		p("\tcase %s:\n\t\treturn %q\n", v.ident, pkgName+"."+v.ident)
+	}
+	p("\t}\n\treturn \"%s.%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n}\n\n", pkgName, t)
+
+	p("// %s zamienia nazwę na wartość typu %s.\n", parse, t)
+	p("func %s(s string) (%s, error) {\n", parse, t)
+	p("\tif v, ok := %s[s]; ok {\n\t\treturn v, nil\n\t}\n", byName)
+	p("\treturn 0, fmt.Errorf(\"invalid %s: %%q\", s)\n}\n\n", t)
+
+	p("// %s zwraca wszystkie wartości w kolejności deklaracji.\n", values)
+	p("func %s() []%s {\n\treturn []%s{\n", values, t, t)
+	for _, v := range e.values {
+		p("\t\t%s,\n", v.ident)
+	}
+	p("\t}\n}\n\n")
+
+	p("// IsValid sprawdza, czy wartość należy do wyliczenia.\n")
+	p("func (i %s) IsValid() bool {\n\t_, ok := %s[i]\n\treturn ok\n}\n\n", t, names)
+
+	p("func (i %s) MarshalText() ([]byte, error) {\n", t)
+	p("\tif !i.IsValid() {\n\t\treturn nil, fmt.Errorf(\"invalid %s: %%d\", int64(i))\n\t}\n", t)
+	p("\treturn []byte(i.String()), nil\n}\n\n")
+
+	p("func (i *%s) UnmarshalText(text []byte) error {\n", t)
+	p("\tv, err := %s(string(text))\n\tif err != nil {\n\t\treturn err\n\t}\n\t*i = v\n\treturn nil\n}\n\n", parse)
+
+	p("func (i %s) MarshalJSON() ([]byte, error) {\n", t)
+	p("\ttext, err := i.MarshalText()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	p("\treturn json.Marshal(string(text))\n}\n\n")
+
+	p("func (i *%s) UnmarshalJSON(data []byte) error {\n", t)
+	p("\tvar s string\n\tif err := json.Unmarshal(data, &s); err != nil {\n")
+	p("\t\treturn fmt.Errorf(\"%s should be a string, got %%s\", data)\n\t}\n", t)
+	p("\treturn i.UnmarshalText([]byte(s))\n}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func isExported(name string) bool {
+	return name != "" && unicode.IsUpper([]rune(name)[0])
+}
+
+func upperFirst(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
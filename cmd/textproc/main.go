@@ -0,0 +1,150 @@
+/*
+Textproc łączy etapy z pakietu textproc w potok sterowany flagami, w kolejności:
+grep -> dedup -> sort -> uniq -> head -> wc.
+
+	go run ./cmd/textproc -wc plik.txt                       # jak wc
+	go run ./cmd/textproc -grep 'error' -i log.txt.gz        # gzip jest rozpoznawany automatycznie
+	go run ./cmd/textproc -split words -sort -uniq -c a.txt  # częstość słów
+	go run ./cmd/textproc -sort -mem 1048576 duzy.txt        # sortowanie zewnętrzne z limitem 1 MiB
+	cat dane.csv | go run ./cmd/textproc -split csv -head 10 -
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"lets-go/textproc"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+)
+
+func main() {
+	split := flag.String("split", "lines", "podział na rekordy: lines, words, csv albo fixed:N")
+	pattern := flag.String("grep", "", "przepuść tylko rekordy pasujące do wyrażenia regularnego")
+	invert := flag.Bool("v", false, "odwróć -grep: przepuść rekordy niepasujące")
+	ignoreCase := flag.Bool("i", false, "-grep bez rozróżniania wielkości liter")
+	mapping := flag.String("map", "", "zmień każdy rekord: lower albo upper")
+	dedup := flag.Bool("dedup", false, "usuń wszystkie powtórzenia (trzyma unikalne rekordy w pamięci)")
+	sorted := flag.Bool("sort", false, "posortuj rekordy")
+	reverse := flag.Bool("r", false, "sortuj malejąco")
+	mem := flag.Int("mem", 64<<20, "limit pamięci sortowania w bajtach; nadmiar trafia do plików tymczasowych")
+	uniq := flag.Bool("uniq", false, "usuń sąsiednie powtórzenia")
+	count := flag.Bool("c", false, "z -uniq: poprzedź rekord liczbą wystąpień")
+	head := flag.Int("head", 0, "przepuść tylko pierwsze N rekordów")
+	wc := flag.Bool("wc", false, "zamiast rekordów wypisz liczbę rekordów, słów, znaków i bajtów")
+	flag.Parse()
+
+	stages, err := buildStages(*pattern, *invert, *ignoreCase, *mapping, *dedup, *sorted, *reverse, *mem,
+		*uniq || *count, *count, *head, *wc)
+	if err != nil {
+		fail(err)
+	}
+	splitFunc, err := textproc.Splitter(*split)
+	if err != nil {
+		fail(err)
+	}
+
+	in, closeAll, err := openInputs(flag.Args())
+	if err != nil {
+		fail(err)
+	}
+	defer closeAll()
+	/*
+		Domyślnie zapis do zamkniętego potoku (textproc ... | head) zabija program sygnałem SIGPIPE, zanim Sort usunie
+		swoje pliki tymczasowe. Po zignorowaniu sygnału zapis zwraca błąd EPIPE, Run normalnie sprząta, a my kończymy bez komunikatu.
+	*/
+	signal.Ignore(syscall.SIGPIPE)
+	if err := textproc.Run(in, os.Stdout, splitFunc, stages...); err != nil {
+		if errors.Is(err, syscall.EPIPE) {
+			return
+		}
+		fail(err)
+	}
+}
+
+func buildStages(pattern string, invert, ignoreCase bool, mapping string, dedup, sorted, reverse bool, mem int,
+	uniq, count bool, head int, wc bool) ([]textproc.Stage, error) {
+	var stages []textproc.Stage
+	if pattern != "" {
+		if ignoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, textproc.Grep(re, invert))
+	}
+	switch mapping {
+	case "":
+	case "lower":
+		stages = append(stages, textproc.Map(strings.ToLower))
+	case "upper":
+		stages = append(stages, textproc.Map(strings.ToUpper))
+	default:
+		return nil, fmt.Errorf("unknown -map %q (want lower or upper)", mapping)
+	}
+	if dedup {
+		stages = append(stages, &textproc.Dedup{})
+	}
+	if sorted {
+		stages = append(stages, &textproc.Sort{MaxMemory: mem, Reverse: reverse})
+	}
+	if uniq {
+		stages = append(stages, &textproc.Uniq{Count: count})
+	}
+	if head > 0 {
+		stages = append(stages, textproc.Head(head))
+	}
+	if wc {
+		stages = append(stages, &textproc.Count{})
+	}
+	return stages, nil
+}
+
+/*
+openInputs skleja pliki z argumentów w jeden strumień, jak cat. Brak argumentów albo "-" oznacza standardowe wejście.
+Pliki gzip są rozpakowywane osobno, więc można mieszać pliki spakowane i zwykłe. Tak jak w cat, plik bez
+końcowego znaku nowej linii skleja swoją ostatnią linię z pierwszą linią następnego pliku.
+*/
+func openInputs(names []string) (io.Reader, func(), error) {
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+	var readers []io.Reader
+	var files []*os.File
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	for _, name := range names {
+		var r io.Reader = os.Stdin
+		if name != "-" {
+			f, err := os.Open(name)
+			if err != nil {
+				closeAll()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			r = f
+		}
+		r, err := textproc.Decompress(r)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		readers = append(readers, r)
+	}
+	return io.MultiReader(readers...), closeAll, nil
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "textproc:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,57 @@
+/*
+Tictactoe to gra w kółko i krzyżyk w terminalu.
+
+	go run ./cmd/tictactoe -ai=o        # człowiek (X) przeciwko komputerowi (O)
+	go run ./cmd/tictactoe -ai=none     # dwóch ludzi przy jednej klawiaturze
+	go run ./cmd/tictactoe -n=5 -k=4    # plansza 5×5, wygrywają 4 w rzędzie
+
+Ruchy wpisujemy jako "wiersz kolumna", np. "2 2"; "q" kończy grę.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"lets-go/tictactoe"
+	"os"
+)
+
+func main() {
+	n := flag.Int("n", 3, "rozmiar planszy")
+	k := flag.Int("k", 0, "liczba znaków w rzędzie potrzebna do wygranej (domyślnie n)")
+	ai := flag.String("ai", "o", "którym znakiem gra komputer: x, o lub none")
+	depth := flag.Int("depth", -1, "głębokość przeszukiwania AI (0 = pełna, domyślnie pełna tylko dla 3×3)")
+	flag.Parse()
+
+	if *k == 0 {
+		*k = *n
+	}
+	if *depth < 0 {
+		*depth = 0
+		if *n > 3 {
+			*depth = 4
+		}
+	}
+
+	cfg := tictactoe.Config{N: *n, K: *k, Depth: *depth}
+	switch *ai {
+	case "x", "X":
+		cfg.Computer = tictactoe.X
+	case "o", "O":
+		cfg.Computer = tictactoe.O
+	case "none":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -ai value %q\n", *ai)
+		os.Exit(2)
+	}
+
+	if _, err := tictactoe.RunTerminal(os.Stdin, os.Stdout, cfg); err != nil {
+		if errors.Is(err, tictactoe.ErrQuit) {
+			fmt.Println("Bye!")
+			return
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
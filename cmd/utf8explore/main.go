@@ -0,0 +1,56 @@
+/*
+Utf8explore pokazuje, jak napis jest zapisany w UTF-8: każdą runę z jej kodem, bajtami, kategorią i nazwą.
+
+	go run ./cmd/utf8explore 'Zażółć 🙋🏽‍♀️'
+	go run ./cmd/utf8explore -escapes 'a\xffb\xe2\x82'    # sekwencje \x.. pozwalają podać niepoprawny UTF-8
+	go run ./cmd/utf8explore -conv 'Wartość'              # koszt konwersji string, []byte i []rune
+	echo 'ąę' | go run ./cmd/utf8explore                   # bez argumentów czyta linie ze standardowego wejścia
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"lets-go/text"
+	"os"
+	"strconv"
+	"unicode/utf8"
+)
+
+func main() {
+	escapes := flag.Bool("escapes", false, "interpretuj sekwencje jak w literale Go, np. \\xff, \\u0105")
+	conv := flag.Bool("conv", false, "zmierz alokacje konwersji między string, []byte i []rune")
+	runs := flag.Int("runs", 1000, "liczba powtórzeń przy pomiarze konwersji")
+	flag.Parse()
+
+	inputs := flag.Args()
+	if len(inputs) == 0 {
+		in := bufio.NewScanner(os.Stdin)
+		for in.Scan() {
+			inputs = append(inputs, in.Text())
+		}
+	}
+
+	status := 0
+	for _, s := range inputs {
+		if *escapes {
+			u, err := strconv.Unquote(`"` + s + `"`)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "utf8explore: %q: %v\n", s, err)
+				status = 1
+				continue
+			}
+			s = u
+		}
+		fmt.Printf("%+q: %d bytes, %d runes, %d graphemes, valid UTF-8: %v\n",
+			s, len(s), utf8.RuneCountInString(s), text.GraphemeCount(s), utf8.ValidString(s))
+		text.WriteTable(os.Stdout, s)
+		if *conv {
+			fmt.Println()
+			text.WriteConversions(os.Stdout, s, *runs)
+		}
+		fmt.Println()
+	}
+	os.Exit(status)
+}
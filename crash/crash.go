@@ -0,0 +1,165 @@
+/*
+Pakiet crash zamienia panikę (ang. panic) w raport o awarii, zamiast kończyć cały program.
+
+Funkcja recover działa tylko wewnątrz funkcji odroczonej (defer) w tej samej goroutinie, w której wystąpiła panika.
+Dlatego Runner opakowuje każdą lekcję (Run) oraz każdą uruchamianą goroutinę (Go) własnym defer z recover.
+Raport zawiera nazwę lekcji, wartość paniki, stos goroutiny oraz wersję Go i system, a jego kopia
+w formacie JSON trafia do katalogu z raportami.
+*/
+package crash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+type Report struct {
+	Lesson    string    `json:"lesson"`
+	Goroutine bool      `json:"goroutine"`
+	Value     string    `json:"value"`
+	ValueType string    `json:"valueType"`
+	Stack     string    `json:"stack"`
+	GoVersion string    `json:"goVersion"`
+	GOOS      string    `json:"goos"`
+	GOARCH    string    `json:"goarch"`
+	Time      time.Time `json:"time"`
+}
+
+func newReport(lesson string, value any, goroutine bool) *Report {
+	return &Report{
+		Lesson:    lesson,
+		Goroutine: goroutine,
+		Value:     fmt.Sprint(value),
+		ValueType: fmt.Sprintf("%T", value),
+		Stack:     string(debug.Stack()),
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		Time:      time.Now(),
+	}
+}
+
+/*
+Guard wywołuje fn i zwraca raport, jeśli fn spanikowało, albo nil, jeśli zakończyło się normalnie.
+Stos zapisywany jest wewnątrz funkcji odroczonej, więc nadal zawiera miejsce wystąpienia paniki.
+*/
+func Guard(lesson string, fn func()) (report *Report) {
+	defer func() {
+		if v := recover(); v != nil {
+			report = newReport(lesson, v, false)
+		}
+	}()
+	fn()
+	return nil
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+/*
+Write zapisuje raport jako plik JSON w katalogu dir i zwraca jego ścieżkę.
+Kilka gorutyn może spanikować w tej samej milisekundzie, więc po znaczniku czasu os.CreateTemp dokleja
+losowy fragment nazwy - każdy raport trafia do osobnego pliku.
+*/
+func (r *Report) Write(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	pattern := fmt.Sprintf("crash-%s-*-%s.json", r.Time.Format("20060102-150405.000"), unsafeChars.ReplaceAllString(r.Lesson, "_"))
+	f, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	// CreateTemp tworzy plik z prawami 0600; raport ma być czytelny tak jak wcześniej.
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+/*
+Runner uruchamia lekcje jedna po drugiej; awaria jednej nie przerywa kolejnych.
+Na koniec ExitCode zwraca 1, jeśli którakolwiek lekcja lub goroutina spanikowała.
+*/
+type Runner struct {
+	Dir string
+	Out io.Writer
+
+	mu      sync.Mutex
+	reports []*Report
+	wg      sync.WaitGroup
+}
+
+func NewRunner(dir string) *Runner {
+	return &Runner{Dir: dir, Out: os.Stderr}
+}
+
+// Run uruchamia lekcję i zwraca false, jeśli spanikowała.
+func (r *Runner) Run(lesson string, fn func()) bool {
+	report := Guard(lesson, fn)
+	if report == nil {
+		return true
+	}
+	r.add(report)
+	return false
+}
+
+// Go uruchamia fn w nowej goroutinie chronionej przez recover. Wait czeka na wszystkie takie goroutiny.
+func (r *Runner) Go(lesson string, fn func()) {
+	r.wg.Go(func() {
+		defer func() {
+			if v := recover(); v != nil {
+				r.add(newReport(lesson, v, true))
+			}
+		}()
+		fn()
+	})
+}
+
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+func (r *Runner) add(report *Report) {
+	r.mu.Lock()
+	r.reports = append(r.reports, report)
+	r.mu.Unlock()
+
+	path, err := report.Write(r.Dir)
+	if err != nil {
+		fmt.Fprintf(r.Out, "lesson %q panicked: %s (could not write crash report: %v)\n", report.Lesson, report.Value, err)
+		return
+	}
+	fmt.Fprintf(r.Out, "lesson %q panicked: %s (report: %s)\n", report.Lesson, report.Value, path)
+}
+
+func (r *Runner) Reports() []*Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Report(nil), r.reports...)
+}
+
+// ExitCode czeka na goroutiny uruchomione przez Go i zwraca kod wyjścia programu.
+func (r *Runner) ExitCode() int {
+	r.Wait()
+	if len(r.Reports()) > 0 {
+		return 1
+	}
+	return 0
+}
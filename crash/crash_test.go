@@ -0,0 +1,109 @@
+package crash
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRunner(t *testing.T) (*Runner, *bytes.Buffer) {
+	var out bytes.Buffer
+	r := NewRunner(t.TempDir())
+	r.Out = &out
+	return r, &out
+}
+
+// TestGoRecoversPanic sprawdza, że panika w gorutynie uruchomionej przez Go trafia do raportu, a nie kończy procesu testów.
+func TestGoRecoversPanic(t *testing.T) {
+	r, out := newTestRunner(t)
+	r.Go("worker", func() {
+		var m map[string]int
+		m["x"] = 1
+	})
+	r.Go("quiet", func() {})
+	if code := r.ExitCode(); code != 1 {
+		t.Errorf("ExitCode() = %d, want 1", code)
+	}
+
+	reports := r.Reports()
+	if len(reports) != 1 {
+		t.Fatalf("got %d reports, want 1", len(reports))
+	}
+	rep := reports[0]
+	if rep.Lesson != "worker" || !rep.Goroutine || !strings.Contains(rep.Value, "nil map") {
+		t.Errorf("report = %+v", rep)
+	}
+	if !strings.Contains(rep.Stack, "crash_test.go") {
+		t.Errorf("stack does not point at the panic:\n%s", rep.Stack)
+	}
+	if !strings.Contains(out.String(), `lesson "worker" panicked`) {
+		t.Errorf("output = %q", out.String())
+	}
+
+	files, err := filepath.Glob(filepath.Join(r.Dir, "crash-*-worker.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("crash files = %v, %v", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved Report
+	if err := json.Unmarshal(data, &saved); err != nil || saved.Value != rep.Value || !saved.Goroutine {
+		t.Errorf("saved report = %+v, %v", saved, err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	r, _ := newTestRunner(t)
+	if !r.Run("fine", func() {}) {
+		t.Error("Run returned false for a lesson that did not panic")
+	}
+	if r.Run("broken", func() { panic("boom") }) {
+		t.Error("Run returned true for a panicking lesson")
+	}
+	ran := false
+	r.Run("next", func() { ran = true })
+	if !ran {
+		t.Error("lesson after a panic did not run")
+	}
+	if code := r.ExitCode(); code != 1 {
+		t.Errorf("ExitCode() = %d, want 1", code)
+	}
+	if reps := r.Reports(); len(reps) != 1 || reps[0].Goroutine || reps[0].ValueType != "string" {
+		t.Errorf("reports = %+v", reps)
+	}
+}
+
+func TestExitCodeWithoutPanics(t *testing.T) {
+	r, _ := newTestRunner(t)
+	r.Run("fine", func() {})
+	r.Go("fine", func() {})
+	if code := r.ExitCode(); code != 0 {
+		t.Errorf("ExitCode() = %d, want 0", code)
+	}
+}
+
+// TestSameMillisecond sprawdza, że raporty z tej samej milisekundy nie nadpisują się nawzajem.
+func TestSameMillisecond(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	paths := map[string]bool{}
+	for i := range 5 {
+		rep := &Report{Lesson: "concurrency", Value: strconv.Itoa(i), Time: now}
+		path, err := rep.Write(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths[path] = true
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "crash-*-concurrency.json"))
+	if err != nil || len(files) != 5 || len(paths) != 5 {
+		t.Errorf("crash files = %v, %v; want 5", files, err)
+	}
+}
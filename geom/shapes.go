@@ -0,0 +1,206 @@
+package geom
+
+import (
+	"math"
+	"sort"
+)
+
+// Tolerancja porównań liczb zmiennoprzecinkowych.
+const eps = 1e-9
+
+type Point = Vec2[float64]
+
+/*
+Shape jest wspólnym interfejsem figur płaskich.
+Każdy typ, który ma te trzy metody, jest figurą - nie trzeba tego nigdzie deklarować.
+*/
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+	Contains(p Point) bool
+}
+
+type Segment struct {
+	A, B Point
+}
+
+func (s Segment) Length() float64 {
+	return s.A.Distance(s.B)
+}
+
+// Contains sprawdza, czy punkt leży na odcinku.
+func (s Segment) Contains(p Point) bool {
+	d := s.B.Sub(s.A)
+	if math.Abs(d.Cross(p.Sub(s.A))) > eps*math.Max(1, d.Len()) {
+		return false
+	}
+	return p.X >= math.Min(s.A.X, s.B.X)-eps && p.X <= math.Max(s.A.X, s.B.X)+eps &&
+		p.Y >= math.Min(s.A.Y, s.B.Y)-eps && p.Y <= math.Max(s.A.Y, s.B.Y)+eps
+}
+
+/*
+Intersect zwraca punkt przecięcia dwóch odcinków.
+Dla odcinków współliniowych, które się nakładają, zwracany jest jeden z końców wspólnej części.
+*/
+func (s Segment) Intersect(o Segment) (Point, bool) {
+	r := s.B.Sub(s.A)
+	q := o.B.Sub(o.A)
+	denom := r.Cross(q)
+	diff := o.A.Sub(s.A)
+
+	if math.Abs(denom) < eps {
+		if math.Abs(diff.Cross(r)) > eps {
+			return Point{}, false // równoległe, ale nie współliniowe
+		}
+		for _, p := range []Point{o.A, o.B, s.A, s.B} {
+			if s.Contains(p) && o.Contains(p) {
+				return p, true
+			}
+		}
+		return Point{}, false
+	}
+
+	t := diff.Cross(q) / denom
+	u := diff.Cross(r) / denom
+	if t < -eps || t > 1+eps || u < -eps || u > 1+eps {
+		return Point{}, false
+	}
+	return s.A.Lerp(s.B, t), true
+}
+
+type Circle struct {
+	Center Point
+	R      float64
+}
+
+func (c Circle) Area() float64 {
+	return math.Pi * c.R * c.R
+}
+
+func (c Circle) Perimeter() float64 {
+	return 2 * math.Pi * c.R
+}
+
+func (c Circle) Contains(p Point) bool {
+	return c.Center.Distance(p) <= c.R+eps
+}
+
+// Rect to prostokąt o bokach równoległych do osi, opisany przez dwa przeciwległe narożniki.
+type Rect struct {
+	Min, Max Point
+}
+
+func (r Rect) Width() float64 { return math.Abs(r.Max.X - r.Min.X) }
+
+func (r Rect) Height() float64 { return math.Abs(r.Max.Y - r.Min.Y) }
+
+func (r Rect) Area() float64 {
+	return r.Width() * r.Height()
+}
+
+func (r Rect) Perimeter() float64 {
+	return 2 * (r.Width() + r.Height())
+}
+
+func (r Rect) Contains(p Point) bool {
+	return p.X >= math.Min(r.Min.X, r.Max.X)-eps && p.X <= math.Max(r.Min.X, r.Max.X)+eps &&
+		p.Y >= math.Min(r.Min.Y, r.Max.Y)-eps && p.Y <= math.Max(r.Min.Y, r.Max.Y)+eps
+}
+
+func (r Rect) Polygon() Polygon {
+	return Polygon{
+		r.Min,
+		{r.Max.X, r.Min.Y},
+		r.Max,
+		{r.Min.X, r.Max.Y},
+	}
+}
+
+// Polygon to wielokąt zadany kolejnymi wierzchołkami; ostatni łączy się z pierwszym.
+type Polygon []Point
+
+func (pg Polygon) Edges() []Segment {
+	edges := make([]Segment, len(pg))
+	for i := range pg {
+		edges[i] = Segment{pg[i], pg[(i+1)%len(pg)]}
+	}
+	return edges
+}
+
+// Area liczy pole wzorem Gaussa (ang. shoelace formula).
+func (pg Polygon) Area() float64 {
+	var twice float64
+	for _, e := range pg.Edges() {
+		twice += e.A.Cross(e.B)
+	}
+	return math.Abs(twice) / 2
+}
+
+func (pg Polygon) Perimeter() float64 {
+	var total float64
+	for _, e := range pg.Edges() {
+		total += e.Length()
+	}
+	return total
+}
+
+/*
+Contains sprawdza przynależność punktu metodą promienia: półprosta wypuszczona z punktu w prawo
+przecina brzeg wielokąta nieparzystą liczbę razy wtedy i tylko wtedy, gdy punkt leży w środku.
+Punkty na brzegu traktujemy jako należące do wielokąta.
+*/
+func (pg Polygon) Contains(p Point) bool {
+	inside := false
+	for _, e := range pg.Edges() {
+		if e.Contains(p) {
+			return true
+		}
+		a, b := e.A, e.B
+		if (a.Y > p.Y) != (b.Y > p.Y) {
+			x := a.X + (p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if p.X < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+/*
+ConvexHull zwraca otoczkę wypukłą zbioru punktów w kolejności przeciwnej do ruchu wskazówek zegara
+(algorytm monotonicznego łańcucha Andrew, O(n log n)). Punkty współliniowe na brzegu są pomijane.
+Dla punktów całkowitych obliczenia są dokładne, bo używają tylko dodawania i mnożenia.
+*/
+func ConvexHull[T Number](points []Vec2[T]) []Vec2[T] {
+	pts := append([]Vec2[T](nil), points...)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].X != pts[j].X {
+			return pts[i].X < pts[j].X
+		}
+		return pts[i].Y < pts[j].Y
+	})
+	if len(pts) < 3 {
+		return pts
+	}
+
+	hull := make([]Vec2[T], 0, 2*len(pts))
+	turnsLeft := func(p Vec2[T]) bool {
+		n := len(hull)
+		return hull[n-1].Sub(hull[n-2]).Cross(p.Sub(hull[n-2])) > 0
+	}
+	for _, p := range pts {
+		for len(hull) >= 2 && !turnsLeft(p) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(pts) - 2; i >= 0; i-- {
+		p := pts[i]
+		for len(hull) >= lower && !turnsLeft(p) {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull[:len(hull)-1]
+}
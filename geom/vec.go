@@ -0,0 +1,81 @@
+/*
+Pakiet geom zawiera podstawy geometrii 2D: generyczny wektor Vec2 oraz figury
+(odcinek, okrąg, wielokąt, prostokąt) wraz z typowymi algorytmami.
+
+Vec2 zastępuje dwa osobne typy punktów z lekcji (Vertex z polami int i Coordinates z polami float64).
+Operacje, których wynik nie musi być liczbą całkowitą (długość, normalizacja, obrót), zawsze zwracają float64.
+*/
+package geom
+
+import "math"
+
+// Number ogranicza typy współrzędnych do liczb całkowitych i zmiennoprzecinkowych.
+type Number interface {
+	~int | ~float64
+}
+
+type Vec2[T Number] struct {
+	X, Y T
+}
+
+func V[T Number](x, y T) Vec2[T] {
+	return Vec2[T]{x, y}
+}
+
+func (v Vec2[T]) Add(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X + o.X, v.Y + o.Y}
+}
+
+func (v Vec2[T]) Sub(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X - o.X, v.Y - o.Y}
+}
+
+func (v Vec2[T]) Scale(k T) Vec2[T] {
+	return Vec2[T]{v.X * k, v.Y * k}
+}
+
+func (v Vec2[T]) Dot(o Vec2[T]) T {
+	return v.X*o.X + v.Y*o.Y
+}
+
+/*
+Cross zwraca składową z iloczynu wektorowego (wyznacznik 2×2).
+Znak mówi, po której stronie v leży o: dodatni - skręt w lewo, ujemny - w prawo, zero - wektory współliniowe.
+*/
+func (v Vec2[T]) Cross(o Vec2[T]) T {
+	return v.X*o.Y - v.Y*o.X
+}
+
+func (v Vec2[T]) Len() float64 {
+	return math.Hypot(float64(v.X), float64(v.Y))
+}
+
+func (v Vec2[T]) Float() Vec2[float64] {
+	return Vec2[float64]{float64(v.X), float64(v.Y)}
+}
+
+// Normalize zwraca wektor jednostkowy o tym samym kierunku. Wektor zerowy pozostaje zerowy.
+func (v Vec2[T]) Normalize() Vec2[float64] {
+	l := v.Len()
+	if l == 0 {
+		return Vec2[float64]{}
+	}
+	return Vec2[float64]{float64(v.X) / l, float64(v.Y) / l}
+}
+
+// Rotate obraca wektor o kąt theta (w radianach) przeciwnie do ruchu wskazówek zegara.
+func (v Vec2[T]) Rotate(theta float64) Vec2[float64] {
+	sin, cos := math.Sincos(theta)
+	x, y := float64(v.X), float64(v.Y)
+	return Vec2[float64]{x*cos - y*sin, x*sin + y*cos}
+}
+
+func (v Vec2[T]) Distance(o Vec2[T]) float64 {
+	return o.Sub(v).Len()
+}
+
+// Lerp interpoluje liniowo między v (t = 0) a o (t = 1).
+func (v Vec2[T]) Lerp(o Vec2[T], t float64) Vec2[float64] {
+	a, b := v.Float(), o.Float()
+	return Vec2[float64]{a.X + (b.X-a.X)*t, a.Y + (b.Y-a.Y)*t}
+}
@@ -10,4 +10,5 @@ func Practice() {
 }
 
 func main() {
+	salesReport()
 }
\ No newline at end of file
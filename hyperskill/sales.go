@@ -0,0 +1,64 @@
+package hyperskill
+
+import (
+	"fmt"
+	"lets-go/table"
+	"os"
+	"strings"
+)
+
+/*
+Zadania z Hyperskill często podają dane jako tabelę na wejściu. Zamiast składać mapy i sumy ręcznie,
+wczytujemy je pakietem table i budujemy raport z filtrowania, grupowania i sortowania.
+*/
+const salesCSV = `miasto,produkt,sztuki,cena,promocja
+Kraków,kawa,3,24.99,nie
+Warszawa,herbata,10,12.50,tak
+Kraków,herbata,4,12.50,nie
+Gdańsk,kawa,1,26.00,nie
+Warszawa,kawa,7,24.99,tak
+Łódź,ciastka,12,,nie
+Gdańsk,ciastka,5,6.40,tak
+`
+
+func salesReport() {
+	t, err := table.ReadCSV(strings.NewReader(salesCSV))
+	if err != nil {
+		fmt.Println("błąd:", err)
+		return
+	}
+	types := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		types[i] = c.Name + ": " + c.Type.String()
+	}
+	fmt.Println(strings.Join(types, ", "))
+
+	// Pusta cena w Łodzi to brak wartości: Count ją liczy, ale Sum, Avg, Min i Max ją pomijają.
+	byCity, err := t.GroupBy([]string{"miasto"},
+		table.Count().Named("zamówienia"), table.Sum("sztuki"), table.Avg("cena"), table.Max("cena"))
+	if err == nil {
+		byCity, err = byCity.SortBy("-sum(sztuki)", "miasto")
+	}
+	if err != nil {
+		fmt.Println("błąd:", err)
+		return
+	}
+	byCity.WriteText(os.Stdout)
+	fmt.Println()
+
+	promo := t.Filter(func(r table.Row) bool { return r.Get("promocja") == true && r.Int("sztuki") >= 5 })
+	promo.WriteMarkdown(os.Stdout)
+	fmt.Println()
+
+	totals, err := t.GroupBy([]string{"produkt"}, table.Sum("sztuki"), table.Min("cena"))
+	if err != nil {
+		fmt.Println("błąd:", err)
+		return
+	}
+	totals.WriteCSV(os.Stdout)
+	totals.WriteJSON(os.Stdout)
+
+	// Agregat pasujący do typu kolumny jest sprawdzany przed liczeniem.
+	_, err = t.GroupBy(nil, table.Sum("produkt"))
+	fmt.Println(err)
+}
@@ -0,0 +1,279 @@
+/*
+Pakiet inspect za pomocą refleksji opisuje dowolną wartość jako drzewo węzłów:
+rodzaj (reflect.Kind), rozmiar i wyrównanie w pamięci, przesunięcia i tagi pól struktur,
+długość, pojemność i adres tablicy pod spodem wycinka, liczbę elementów mapy oraz łańcuchy wskaźników.
+
+Rozmiar z reflect.Type.Size() to dokładnie to samo, co zwraca unsafe.Sizeof dla wartości danego typu,
+a Align() odpowiada unsafe.Alignof. Refleksja pozwala jednak policzyć je dla typu znanego dopiero w czasie działania.
+*/
+package inspect
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/*
+Node opisuje jedną wartość. Pola, które nie mają sensu dla danego rodzaju, są puste,
+np. Len i Cap mają tylko wycinki, mapy, kanały, tablice i stringi.
+*/
+type Node struct {
+	Name  string  `json:"name,omitempty"` // nazwa pola, indeks [i], klucz mapy lub "*" dla wskazywanej wartości
+	Type  string  `json:"type"`
+	Kind  string  `json:"kind"`
+	Size  uintptr `json:"size"`
+	Align int     `json:"align"`
+
+	// Tylko dla pól struktur.
+	Offset     *uintptr `json:"offset,omitempty"`
+	Padding    uintptr  `json:"padding,omitempty"` // puste bajty za polem, dodane dla wyrównania następnego
+	Tag        string   `json:"tag,omitempty"`
+	Embedded   bool     `json:"embedded,omitempty"`
+	Unexported bool     `json:"unexported,omitempty"`
+
+	Value string `json:"value,omitempty"` // wartość typów prostych lub wynik String()
+	Len   *int   `json:"len,omitempty"`
+	Cap   *int   `json:"cap,omitempty"`
+	Addr  string `json:"addr,omitempty"` // adres wskazywany przez wskaźnik lub adres tablicy pod wycinkiem
+	Nil   bool   `json:"nil,omitempty"`
+
+	Cycle     bool    `json:"cycle,omitempty"`     // wskaźnik prowadzi do wartości, która już jest w drzewie
+	Truncated int     `json:"truncated,omitempty"` // liczba pominiętych elementów (Options.MaxElems)
+	Children  []*Node `json:"children,omitempty"`
+}
+
+// Options ogranicza rozmiar drzewa; wartości zerowe oznaczają domyślne limity.
+type Options struct {
+	MaxDepth int // domyślnie 8
+	MaxElems int // ile elementów wycinka, tablicy lub mapy pokazać, domyślnie 8
+
+	// Expand rozwija też wartości implementujące fmt.Stringer (np. time.Time),
+	// które domyślnie są pokazywane tylko jako wynik String().
+	Expand bool
+}
+
+type inspector struct {
+	opts Options
+	seen map[visit]bool
+}
+
+type visit struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// Inspect opisuje v z domyślnymi limitami.
+func Inspect(v any) *Node {
+	return InspectWith(v, Options{})
+}
+
+// InspectWith opisuje v z podanymi limitami.
+func InspectWith(v any, opts Options) *Node {
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = 8
+	}
+	if opts.MaxElems <= 0 {
+		opts.MaxElems = 8
+	}
+	in := &inspector{opts: opts, seen: map[visit]bool{}}
+	if v == nil {
+		return &Node{Type: "nil", Kind: "invalid", Nil: true}
+	}
+	return in.node(reflect.ValueOf(v), 0)
+}
+
+var stringerType = reflect.TypeFor[fmt.Stringer]()
+
+func (in *inspector) node(v reflect.Value, depth int) *Node {
+	t := v.Type()
+	n := &Node{
+		Type:  t.String(),
+		Kind:  t.Kind().String(),
+		Size:  t.Size(),
+		Align: t.Align(),
+	}
+
+	str, isStringer := stringValue(v)
+	if isScalar(t.Kind()) {
+		n.Value = scalar(v)
+		if isStringer && str != n.Value {
+			n.Value += " (" + str + ")"
+		}
+		if t.Kind() == reflect.String {
+			n.Len = ptr(v.Len())
+		}
+		return n
+	}
+	if isStringer && !in.opts.Expand && t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface {
+		n.Value = strconv.Quote(shorten(str, 60))
+		return n
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Func, reflect.Chan, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			n.Nil = true
+			return n
+		}
+	}
+	if depth >= in.opts.MaxDepth {
+		n.Truncated = -1
+		return n
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		n.Addr = addr(v.Pointer())
+		key := visit{v.Pointer(), t}
+		if in.seen[key] {
+			n.Cycle = true
+			return n
+		}
+		in.seen[key] = true
+		in.add(n, "*", v.Elem(), depth)
+	case reflect.Interface:
+		in.add(n, "("+v.Elem().Type().String()+")", v.Elem(), depth)
+	case reflect.Struct:
+		in.fields(n, v, depth)
+	case reflect.Array:
+		n.Len = ptr(v.Len())
+		in.elems(n, v, depth)
+	case reflect.Slice:
+		n.Len, n.Cap = ptr(v.Len()), ptr(v.Cap())
+		n.Addr = addr(v.Pointer())
+		in.elems(n, v, depth)
+	case reflect.Map:
+		n.Len = ptr(v.Len())
+		n.Addr = addr(v.Pointer())
+		in.entries(n, v, depth)
+	case reflect.Chan:
+		n.Len, n.Cap = ptr(v.Len()), ptr(v.Cap())
+		n.Addr = addr(v.Pointer())
+	case reflect.Func, reflect.UnsafePointer:
+		n.Addr = addr(v.Pointer())
+	}
+	return n
+}
+
+func (in *inspector) add(parent *Node, name string, v reflect.Value, depth int) *Node {
+	child := in.node(v, depth+1)
+	child.Name = name
+	parent.Children = append(parent.Children, child)
+	return child
+}
+
+/*
+fields opisuje pola struktury razem z ich przesunięciem od początku struktury.
+Padding to bajty pomiędzy końcem pola a początkiem następnego (albo końcem struktury) -
+kompilator je dodaje, żeby każde pole leżało pod adresem podzielnym przez jego wyrównanie.
+*/
+func (in *inspector) fields(n *Node, v reflect.Value, depth int) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		child := in.add(n, f.Name, v.Field(i), depth)
+		off := f.Offset
+		child.Offset = &off
+		child.Tag = string(f.Tag)
+		child.Embedded = f.Anonymous
+		child.Unexported = !f.IsExported()
+
+		end := t.Size()
+		if i+1 < t.NumField() {
+			end = t.Field(i + 1).Offset
+		}
+		child.Padding = end - (f.Offset + f.Type.Size())
+	}
+}
+
+func (in *inspector) elems(n *Node, v reflect.Value, depth int) {
+	for i := 0; i < v.Len(); i++ {
+		if i == in.opts.MaxElems {
+			n.Truncated = v.Len() - i
+			return
+		}
+		in.add(n, "["+strconv.Itoa(i)+"]", v.Index(i), depth)
+	}
+}
+
+// entries opisuje elementy mapy posortowane po kluczu, bo kolejność iteracji po mapie jest losowa.
+func (in *inspector) entries(n *Node, v reflect.Value, depth int) {
+	type entry struct {
+		key string
+		val reflect.Value
+	}
+	var all []entry
+	for it := v.MapRange(); it.Next(); {
+		key := scalar(it.Key())
+		if s, ok := stringValue(it.Key()); ok {
+			key = s
+		}
+		all = append(all, entry{key, it.Value()})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].key < all[j].key })
+	for i, e := range all {
+		if i == in.opts.MaxElems {
+			n.Truncated = len(all) - i
+			return
+		}
+		in.add(n, "["+e.key+"]", e.val, depth)
+	}
+}
+
+func isScalar(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+/*
+scalar formatuje wartość typu prostego. Metody Int(), String() itd. działają także dla pól nieeksportowanych -
+tylko Interface() jest dla nich zabronione, dlatego nie używamy tu fmt.Sprint(v.Interface()).
+*/
+func scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.String:
+		return strconv.Quote(shorten(v.String(), 40))
+	}
+	return v.Type().String()
+}
+
+// stringValue zwraca wynik String() dla wartości implementujących fmt.Stringer.
+func stringValue(v reflect.Value) (string, bool) {
+	if !v.CanInterface() || !v.Type().Implements(stringerType) {
+		return "", false
+	}
+	if k := v.Kind(); (k == reflect.Pointer || k == reflect.Interface) && v.IsNil() {
+		return "", false
+	}
+	return v.Interface().(fmt.Stringer).String(), true
+}
+
+func shorten(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max]) + "…"
+}
+
+func ptr(i int) *int { return &i }
+
+func addr(p uintptr) string { return fmt.Sprintf("%#x", p) }
@@ -0,0 +1,95 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+Tree wypisuje węzeł jako drzewo, np.:
+
+	basics.Vertex struct size=16 align=8
+	├── X int off=0 size=8 = 1
+	└── Y int off=8 size=8 = 2
+*/
+func Tree(w io.Writer, n *Node) error {
+	var b strings.Builder
+	writeTree(&b, n, "", "")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeTree(b *strings.Builder, n *Node, prefix, childPrefix string) {
+	b.WriteString(prefix)
+	b.WriteString(n.line())
+	b.WriteByte('\n')
+	for i, c := range n.Children {
+		last := i == len(n.Children)-1 && n.Truncated == 0
+		if last {
+			writeTree(b, c, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeTree(b, c, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+	switch {
+	case n.Truncated > 0:
+		fmt.Fprintf(b, "%s└── … %d more\n", childPrefix, n.Truncated)
+	case n.Truncated < 0:
+		fmt.Fprintf(b, "%s└── … max depth\n", childPrefix)
+	}
+}
+
+// line opisuje węzeł w jednej linii: nazwa, typ, układ w pamięci, a na końcu wartość i tag.
+func (n *Node) line() string {
+	var parts []string
+	if n.Name != "" {
+		parts = append(parts, n.Name)
+	}
+	parts = append(parts, n.Type)
+	if n.Kind != n.Type {
+		parts = append(parts, n.Kind)
+	}
+	if n.Offset != nil {
+		parts = append(parts, fmt.Sprintf("off=%d", *n.Offset))
+	}
+	parts = append(parts, fmt.Sprintf("size=%d", n.Size))
+	if n.Offset == nil {
+		parts = append(parts, fmt.Sprintf("align=%d", n.Align))
+	}
+	if n.Padding > 0 {
+		parts = append(parts, fmt.Sprintf("pad=%d", n.Padding))
+	}
+	if n.Len != nil {
+		parts = append(parts, fmt.Sprintf("len=%d", *n.Len))
+	}
+	if n.Cap != nil {
+		parts = append(parts, fmt.Sprintf("cap=%d", *n.Cap))
+	}
+	if n.Addr != "" {
+		parts = append(parts, "@"+n.Addr)
+	}
+	switch {
+	case n.Nil:
+		parts = append(parts, "= nil")
+	case n.Cycle:
+		parts = append(parts, "(cycle)")
+	case n.Value != "":
+		parts = append(parts, "= "+n.Value)
+	}
+	if n.Embedded {
+		parts = append(parts, "(embedded)")
+	}
+	if n.Tag != "" {
+		parts = append(parts, "`"+n.Tag+"`")
+	}
+	return strings.Join(parts, " ")
+}
+
+// JSON zapisuje węzeł razem z dziećmi jako sformatowany JSON.
+func JSON(w io.Writer, n *Node) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(n)
+}
@@ -14,8 +14,10 @@ package main
 import (
 	"fmt"
 	"lets-go/basics"
+	"lets-go/crash"
 	"math"
 	"lets-go/hyperskill"
+	"os"
 )
 
 /*
@@ -33,12 +35,21 @@ func main() {
 	//fmt.Println(math.pi)
 	fmt.Println(math.Pi)
 
-	basics.TestFunctions()
-	basics.TestVariables()
-	basics.TestFlowControl()
-	basics.TestStructures()
-	basics.TestMethodsAndInterfaces()
-	basics.TestGenerics()
-	basics.TestConcurrency()
-	hyperskill.Practice()
+	/*
+	 Każda lekcja jest uruchamiana przez crash.Runner. Jeśli któraś spanikuje, raport trafia do katalogu crashes,
+	 a pozostałe lekcje wykonują się dalej. Program kończy się wtedy kodem wyjścia 1.
+	*/
+	r := crash.NewRunner("crashes")
+	r.Run("functions", basics.TestFunctions)
+	r.Run("variables", basics.TestVariables)
+	r.Run("flow_control", basics.TestFlowControl)
+	r.Run("structures", basics.TestStructures)
+	r.Run("methods_and_interfaces", basics.TestMethodsAndInterfaces)
+	r.Run("formatting", basics.TestFormatting)
+	r.Run("embedding", basics.TestEmbedding)
+	r.Run("generics", basics.TestGenerics)
+	r.Run("concurrency", func() { basics.TestConcurrency(r) })
+	r.Run("streams", basics.TestStreams)
+	r.Run("hyperskill", hyperskill.Practice)
+	os.Exit(r.ExitCode())
 }
@@ -0,0 +1,211 @@
+/*
+Pakiet methodset pokazuje zbiory metod typów i skąd pochodzi każda metoda: czy jest zadeklarowana na samym typie,
+czy promowana z osadzonego pola (i przez jaką ścieżkę pól), a także które metody są przesłonięte, a które niedostępne
+z powodu niejednoznacznego selektora.
+
+Refleksja zna tylko metody eksportowane, więc metody z małej litery są pomijane.
+Metodę zadeklarowaną w kodzie od metody promowanej odróżniamy po tym, że dla promowanej kompilator generuje
+funkcję opakowującą (ang. wrapper), której runtime przypisuje plik "<autogenerated>".
+*/
+package methodset
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// Method opisuje jedną metodę ze zbioru metod typu.
+type Method struct {
+	Name       string
+	Signature  string   // bez odbiorcy, np. "func(string) error"
+	DeclaredOn string   // typ, w którym metoda jest zadeklarowana, np. "basics.animal" albo "basics.store (interface)"
+	Receiver   string   // "T" (odbiorca wartości), "*T" (odbiorca wskaźnikowy) albo "interface"
+	Path       []string // ścieżka osadzonych pól, przez którą metoda jest promowana; pusta dla metod własnych
+	Shadows    [][]string
+}
+
+// Promoted mówi, czy metoda pochodzi z osadzonego pola.
+func (m Method) Promoted() bool {
+	return len(m.Path) > 0
+}
+
+// Via zwraca ścieżkę pól w postaci selektora, np. "pet.animal", albo "-" dla metod własnych.
+func (m Method) Via() string {
+	if len(m.Path) == 0 {
+		return "-"
+	}
+	return strings.Join(m.Path, ".")
+}
+
+// Conflict to nazwa metody, która występuje na tej samej głębokości w kilku osadzonych polach.
+// Selektor x.Name jest wtedy niejednoznaczny, więc metoda nie należy do zbioru metod.
+type Conflict struct {
+	Name  string
+	Paths [][]string
+}
+
+// candidate to metoda zadeklarowana w typie osiągalnym przez ścieżkę osadzonych pól.
+type candidate struct {
+	path       []string
+	declaredOn string
+	receiver   string
+}
+
+/*
+Of zwraca zbiór metod typu t w kolejności alfabetycznej (tak jak reflect), z pochodzeniem każdej metody.
+Dla t będącego wskaźnikiem *T zbiór zawiera także metody T; wtedy Receiver mówi, która to metoda.
+*/
+func Of(t reflect.Type) []Method {
+	cands := candidates(t)
+	methods := make([]Method, 0, t.NumMethod())
+	for i := range t.NumMethod() {
+		rm := t.Method(i)
+		m := Method{Name: rm.Name, Signature: signature(rm.Type, t.Kind() != reflect.Interface)}
+		if t.Kind() == reflect.Interface {
+			m.DeclaredOn, m.Receiver = t.String()+" (interface)", "interface"
+			methods = append(methods, m)
+			continue
+		}
+		found := cands[rm.Name]
+		if len(found) > 0 {
+			best := found[0]
+			m.DeclaredOn, m.Receiver, m.Path = best.declaredOn, best.receiver, best.path
+			for _, c := range found[1:] {
+				m.Shadows = append(m.Shadows, c.path)
+			}
+		}
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+/*
+Conflicts zwraca metody, których nie ma w zbiorze metod t, bo są promowane z kilku osadzonych pól
+na tej samej (najpłytszej) głębokości. Kompilator zgłasza wtedy "ambiguous selector" dopiero przy użyciu.
+*/
+func Conflicts(t reflect.Type) []Conflict {
+	var conflicts []Conflict
+	for name, found := range candidates(t) {
+		if _, ok := reflect.PointerTo(base(t)).MethodByName(name); ok {
+			continue
+		}
+		depth := len(found[0].path)
+		c := Conflict{Name: name}
+		for _, f := range found {
+			if len(f.path) == depth {
+				c.Paths = append(c.Paths, f.path)
+			}
+		}
+		if depth > 0 && len(c.Paths) > 1 {
+			conflicts = append(conflicts, c)
+		}
+	}
+	slices.SortFunc(conflicts, func(a, b Conflict) int { return strings.Compare(a.Name, b.Name) })
+	return conflicts
+}
+
+func base(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
+
+/*
+candidates zbiera metody zadeklarowane w t i we wszystkich typach osadzonych (na dowolnej głębokości),
+przeszukując wszerz. Dla każdej nazwy pierwsza pozycja listy jest najpłytsza - to ją wybiera selektor.
+Ten sam typ może być osiągalny kilkoma ścieżkami (np. type C struct{ A; B }, gdzie A i B osadzają Base) i każdą
+trzeba zapisać, bo dwie ścieżki na tej samej głębokości to niejednoznaczny selektor. Pomijamy tylko typy,
+które już są na bieżącej ścieżce - inaczej type node struct{ *node } dawałby nieskończenie długie ścieżki.
+*/
+func candidates(t reflect.Type) map[string][]candidate {
+	cands := map[string][]candidate{}
+	type level struct {
+		t     reflect.Type
+		path  []string
+		types []reflect.Type // typy na ścieżce od t do bieżącego, włącznie
+	}
+	queue := []level{{base(t), nil, []reflect.Type{base(t)}}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for name, c := range declared(cur.t) {
+			c.path = cur.path
+			cands[name] = append(cands[name], c)
+		}
+		if cur.t.Kind() != reflect.Struct {
+			continue
+		}
+		for i := range cur.t.NumField() {
+			f := cur.t.Field(i)
+			ft := base(f.Type)
+			if !f.Anonymous || slices.Contains(cur.types, ft) {
+				continue
+			}
+			queue = append(queue, level{ft, append(slices.Clone(cur.path), f.Name), append(slices.Clone(cur.types), ft)})
+		}
+	}
+	return cands
+}
+
+// declared zwraca metody zadeklarowane bezpośrednio w typie t (z odbiorcą t albo *t), bez promowanych.
+func declared(t reflect.Type) map[string]candidate {
+	out := map[string]candidate{}
+	if t.Kind() == reflect.Interface {
+		for i := range t.NumMethod() {
+			out[t.Method(i).Name] = candidate{declaredOn: t.String() + " (interface)", receiver: "interface"}
+		}
+		return out
+	}
+	ptr := reflect.PointerTo(t)
+	for i := range ptr.NumMethod() {
+		name := ptr.Method(i).Name
+		if m, ok := t.MethodByName(name); ok && written(m) {
+			out[name] = candidate{declaredOn: t.String(), receiver: "T"}
+		} else if written(ptr.Method(i)) {
+			out[name] = candidate{declaredOn: t.String(), receiver: "*T"}
+		}
+	}
+	return out
+}
+
+// written mówi, czy metoda jest napisana w kodzie źródłowym, a nie wygenerowana przez kompilator dla promocji.
+func written(m reflect.Method) bool {
+	f := runtime.FuncForPC(m.Func.Pointer())
+	if f == nil {
+		return false
+	}
+	file, _ := f.FileLine(f.Entry())
+	return file != "<autogenerated>"
+}
+
+// signature zapisuje typ metody bez odbiorcy; skipRecv mówi, czy pierwszy argument funkcji to odbiorca.
+func signature(ft reflect.Type, skipRecv bool) string {
+	start := 0
+	if skipRecv {
+		start = 1
+	}
+	in := make([]string, 0, ft.NumIn())
+	for i := start; i < ft.NumIn(); i++ {
+		if ft.IsVariadic() && i == ft.NumIn()-1 {
+			in = append(in, "..."+ft.In(i).Elem().String())
+			continue
+		}
+		in = append(in, ft.In(i).String())
+	}
+	s := "func(" + strings.Join(in, ", ") + ")"
+	switch ft.NumOut() {
+	case 0:
+	case 1:
+		s += " " + ft.Out(0).String()
+	default:
+		out := make([]string, ft.NumOut())
+		for i := range out {
+			out[i] = ft.Out(i).String()
+		}
+		s += " (" + strings.Join(out, ", ") + ")"
+	}
+	return s
+}
@@ -0,0 +1,156 @@
+package methodset
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type Base struct{}
+
+func (Base) Hello() string { return "base" }
+func (*Base) Reset()       {}
+
+type Left struct{ Base }
+type Right struct{ Base }
+
+// Diamond osadza Base dwiema ścieżkami na tej samej głębokości, więc Hello i Reset są niejednoznaczne.
+type Diamond struct {
+	Left
+	Right
+}
+
+// Shadow deklaruje własne Hello, które przesłania Base.Hello.
+type Shadow struct{ Base }
+
+func (Shadow) Hello() string { return "shadow" }
+
+// DeepShadow ma Hello z Base na głębokości 1 i z Left.Base na głębokości 2.
+type DeepShadow struct {
+	Base
+	Left
+}
+
+// Ptr osadza wskaźnik, więc metody *Base należą także do zbioru metod wartości Ptr.
+type Ptr struct{ *Base }
+
+type Greeter interface{ Greet(name string) string }
+
+type WithIface struct{ Greeter }
+
+type Node struct{ *Node }
+
+func (*Node) Walk() {}
+
+type summary struct {
+	name       string
+	declaredOn string
+	receiver   string
+	via        string
+	shadows    string
+}
+
+func summarize(methods []Method) []summary {
+	var out []summary
+	for _, m := range methods {
+		out = append(out, summary{m.Name, m.DeclaredOn, m.Receiver, m.Via(), joinPaths(m.Shadows)})
+	}
+	return out
+}
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		name string
+		t    reflect.Type
+		want []summary
+	}{
+		{"own methods", reflect.TypeFor[*Base](), []summary{
+			{"Hello", "methodset.Base", "T", "-", ""},
+			{"Reset", "methodset.Base", "*T", "-", ""},
+		}},
+		{"value set", reflect.TypeFor[Base](), []summary{
+			{"Hello", "methodset.Base", "T", "-", ""},
+		}},
+		{"diamond", reflect.TypeFor[*Diamond](), nil},
+		{"shadowing", reflect.TypeFor[*Shadow](), []summary{
+			{"Hello", "methodset.Shadow", "T", "-", "Base"},
+			{"Reset", "methodset.Base", "*T", "Base", ""},
+		}},
+		{"deeper path shadowed", reflect.TypeFor[*DeepShadow](), []summary{
+			{"Hello", "methodset.Base", "T", "Base", "Left.Base"},
+			{"Reset", "methodset.Base", "*T", "Base", "Left.Base"},
+		}},
+		{"embedded pointer", reflect.TypeFor[Ptr](), []summary{
+			{"Hello", "methodset.Base", "T", "Base", ""},
+			{"Reset", "methodset.Base", "*T", "Base", ""},
+		}},
+		{"embedded interface", reflect.TypeFor[WithIface](), []summary{
+			{"Greet", "methodset.Greeter (interface)", "interface", "Greeter", ""},
+		}},
+		{"interface", reflect.TypeFor[Greeter](), []summary{
+			{"Greet", "methodset.Greeter (interface)", "interface", "-", ""},
+		}},
+		{"self-embedding", reflect.TypeFor[*Node](), []summary{
+			{"Walk", "methodset.Node", "*T", "-", ""},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarize(Of(tt.t))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Of(%v) =\n%v\nwant\n%v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignature(t *testing.T) {
+	methods := Of(reflect.TypeFor[WithIface]())
+	if got, want := methods[0].Signature, "func(string) string"; got != want {
+		t.Errorf("Signature = %q, want %q", got, want)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	tests := []struct {
+		name string
+		t    reflect.Type
+		want []string
+	}{
+		{"diamond", reflect.TypeFor[Diamond](), []string{"Hello: Left.Base, Right.Base", "Reset: Left.Base, Right.Base"}},
+		{"diamond pointer", reflect.TypeFor[*Diamond](), []string{"Hello: Left.Base, Right.Base", "Reset: Left.Base, Right.Base"}},
+		{"shadowing", reflect.TypeFor[Shadow](), nil},
+		{"deeper path shadowed", reflect.TypeFor[DeepShadow](), nil},
+		{"self-embedding", reflect.TypeFor[Node](), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range Conflicts(tt.t) {
+				got = append(got, c.Name+": "+joinPaths(c.Paths))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Conflicts(%v) = %q, want %q", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteAmbiguous(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, reflect.TypeFor[Diamond]()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"method sets of methodset.Diamond (0) and *methodset.Diamond (0):",
+		"ambiguous Hello: Left.Base, Right.Base (not in either method set)",
+		"ambiguous Reset: Left.Base, Right.Base (not in either method set)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Write output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package methodset
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+)
+
+/*
+Write wypisuje zbiory metod T i *T obok siebie, z pochodzeniem każdej metody:
+
+	METHOD  T    *T   DECLARED ON           RECV  VIA         SIGNATURE
+	Name    yes  yes  basics.dog            T     -           func() string
+	Rename  -    yes  basics.animal         *T    pet.animal  func(string)
+
+Metoda z odbiorcą wskaźnikowym jest tylko w zbiorze *T - wartości T nie można więc przypisać do interfejsu,
+który jej wymaga. Na końcu wypisywane są niejednoznaczne selektory, których nie ma w żadnym ze zbiorów.
+*/
+func Write(w io.Writer, t reflect.Type) error {
+	t = base(t)
+	ptr := reflect.PointerTo(t)
+	inValue := map[string]bool{}
+	for i := range t.NumMethod() {
+		inValue[t.Method(i).Name] = true
+	}
+	fmt.Fprintf(w, "method sets of %s (%d) and %s (%d):\n", t, t.NumMethod(), ptr, ptr.NumMethod())
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  METHOD\tT\t*T\tDECLARED ON\tRECV\tVIA\tSIGNATURE")
+	for _, m := range Of(ptr) {
+		inT := "-"
+		if inValue[m.Name] {
+			inT = "yes"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\tyes\t%s\t%s\t%s\t%s", m.Name, inT, m.DeclaredOn, m.Receiver, m.Via(), m.Signature)
+		if len(m.Shadows) > 0 {
+			fmt.Fprintf(tw, "\tshadows %s", joinPaths(m.Shadows))
+		}
+		fmt.Fprintln(tw)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, c := range Conflicts(t) {
+		fmt.Fprintf(w, "  ambiguous %s: %s (not in either method set)\n", c.Name, joinPaths(c.Paths))
+	}
+	return nil
+}
+
+func joinPaths(paths [][]string) string {
+	s := make([]string, len(paths))
+	for i, p := range paths {
+		s[i] = strings.Join(p, ".")
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,168 @@
+/*
+Pakiet multierr zbiera wiele błędów z operacji wsadowych (ang. batch), zamiast kończyć na pierwszym.
+
+Każdy błąd zapamiętywany jest razem z indeksem elementu, którego dotyczy ("item 3: can't work with 42").
+Zebrane błędy można zdeduplikować, pogrupować według błędów sentinel lub typu i wypisać jako tabelę.
+Errors implementuje Unwrap() []error, więc errors.Is i errors.As sprawdzają wszystkie zebrane błędy,
+a wynik można łączyć z errors.Join.
+*/
+package multierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Item to błąd przypisany do elementu wsadu o danym indeksie.
+type Item struct {
+	Index int
+	Err   error
+}
+
+func (i Item) Error() string {
+	return fmt.Sprintf("item %d: %v", i.Index, i.Err)
+}
+
+func (i Item) Unwrap() error { return i.Err }
+
+// Errors jest gotowe do użycia jako wartość zerowa: var errs multierr.Errors.
+type Errors struct {
+	items []Item
+}
+
+// Add dodaje błąd elementu o indeksie index. Błędy nil są pomijane.
+func (e *Errors) Add(index int, err error) {
+	if err == nil {
+		return
+	}
+	e.items = append(e.items, Item{index, err})
+}
+
+/*
+Merge dołącza błędy z innego źródła. Wynik errors.Join oraz inne *Errors są spłaszczane,
+a zachowane są ich indeksy (dla errors.Join jest to -1, bo nie wiadomo, którego elementu dotyczą).
+*/
+func (e *Errors) Merge(err error) {
+	switch v := err.(type) {
+	case nil:
+	case *Errors:
+		e.items = append(e.items, v.items...)
+	case Item:
+		e.items = append(e.items, v)
+	case interface{ Unwrap() []error }:
+		for _, inner := range v.Unwrap() {
+			e.Merge(inner)
+		}
+	default:
+		e.Add(-1, err)
+	}
+}
+
+func (e *Errors) Len() int { return len(e.items) }
+
+func (e *Errors) Items() []Item { return append([]Item(nil), e.items...) }
+
+/*
+Err zwraca nil, gdy nie zebrano żadnego błędu. Należy zwracać wynik Err, a nie samo *Errors,
+bo pusty wskaźnik opakowany w interfejs error nie jest równy nil.
+*/
+func (e *Errors) Err() error {
+	if e == nil || len(e.items) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *Errors) Error() string {
+	if len(e.items) == 1 {
+		return e.items[0].Error()
+	}
+	msgs := make([]string, len(e.items))
+	for i, item := range e.items {
+		msgs[i] = item.Error()
+	}
+	return fmt.Sprintf("%d errors: %s", len(e.items), strings.Join(msgs, "; "))
+}
+
+func (e *Errors) Unwrap() []error {
+	errs := make([]error, len(e.items))
+	for i, item := range e.items {
+		errs[i] = item
+	}
+	return errs
+}
+
+// Group to zbiór błędów o wspólnym kluczu wraz z indeksami elementów, których dotyczą.
+type Group struct {
+	Key     string
+	Indices []int
+	Errs    []error
+}
+
+func (g Group) Count() int { return len(g.Errs) }
+
+// Dedup łączy błędy o identycznym komunikacie; grupy są w kolejności pierwszego wystąpienia.
+func (e *Errors) Dedup() []Group {
+	return e.group(func(err error) string { return err.Error() })
+}
+
+/*
+GroupBy grupuje błędy według pierwszego pasującego (errors.Is) błędu sentinel z listy,
+a błędy, które nie pasują do żadnego, według typu najgłębszej przyczyny, np. "*basics.argError".
+*/
+func (e *Errors) GroupBy(sentinels ...error) []Group {
+	return e.group(func(err error) string {
+		for _, s := range sentinels {
+			if errors.Is(err, s) {
+				return s.Error()
+			}
+		}
+		return fmt.Sprintf("%T", rootCause(err))
+	})
+}
+
+func rootCause(err error) error {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+func (e *Errors) group(key func(error) string) []Group {
+	var groups []Group
+	index := make(map[string]int)
+	for _, item := range e.items {
+		k := key(item.Err)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, Group{Key: k})
+		}
+		groups[i].Indices = append(groups[i].Indices, item.Index)
+		groups[i].Errs = append(groups[i].Errs, item.Err)
+	}
+	return groups
+}
+
+/*
+Map wywołuje fn dla każdego elementu i zbiera wszystkie błędy, zamiast przerywać na pierwszym.
+Wyniki dla elementów zakończonych błędem mają wartość zerową.
+*/
+func Map[T, R any](inputs []T, fn func(T) (R, error)) ([]R, error) {
+	results := make([]R, len(inputs))
+	var errs Errors
+	for i, in := range inputs {
+		r, err := fn(in)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		results[i] = r
+	}
+	return results, errs.Err()
+}
@@ -0,0 +1,39 @@
+package multierr
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+/*
+Table renderuje podsumowanie grup jako tabelę:
+
+	GROUP               COUNT  ITEMS
+	can't work with 42  2      1, 3
+*/
+func Table(groups []Group) string {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "GROUP\tCOUNT\tITEMS")
+	total := 0
+	for _, g := range groups {
+		indices := make([]string, len(g.Indices))
+		for i, idx := range g.Indices {
+			indices[i] = "-"
+			if idx >= 0 {
+				indices[i] = fmt.Sprint(idx)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", g.Key, g.Count(), strings.Join(indices, ", "))
+		total += g.Count()
+	}
+	fmt.Fprintf(tw, "TOTAL\t%d\n", total)
+	tw.Flush()
+	return sb.String()
+}
+
+// Summary to skrót dla Table(e.GroupBy(sentinels...)).
+func (e *Errors) Summary(sentinels ...error) string {
+	return Table(e.GroupBy(sentinels...))
+}
@@ -0,0 +1,255 @@
+/*
+Pakiet numeric zawiera metody numeryczne szukania miejsc zerowych funkcji, czyli takich x, że f(x) = 0.
+
+Każda metoda przyjmuje Options z tolerancją i limitem iteracji i zwraca Result ze ścieżką zbieżności (Trace),
+więc można zobaczyć, jak szybko kolejne przybliżenia zbliżają się do wyniku:
+  - Newton  - zbieżność kwadratowa, wymaga pochodnej (lub liczy ją numerycznie) i dobrego punktu startowego,
+  - Secant  - jak Newton, ale pochodną zastępuje iloraz różnicowy z dwóch ostatnich punktów,
+  - Bisection - zawsze zbieżna, ale wolna; wymaga przedziału [a, b], na którego końcach f ma różne znaki,
+  - Brent   - łączy bisekcję z interpolacją: niezawodna jak bisekcja i zwykle szybka jak metoda siecznych.
+*/
+package numeric
+
+import (
+	"errors"
+	"math"
+)
+
+type Func func(x float64) float64
+
+var (
+	ErrNoConvergence  = errors.New("numeric: did not converge")
+	ErrNotBracketed   = errors.New("numeric: root is not bracketed, f(a) and f(b) must have opposite signs")
+	ErrZeroDerivative = errors.New("numeric: derivative is zero")
+	ErrDomain         = errors.New("numeric: argument out of domain")
+)
+
+/*
+Options z wartościami zerowymi oznacza tolerancję 1e-12 i 100 iteracji.
+Dla metod Newtona i siecznych tolerancja jest względna, gdy |x| > 1, a bezwzględna w przeciwnym razie.
+*/
+type Options struct {
+	Tol     float64
+	MaxIter int
+}
+
+func (o Options) withDefaults() Options {
+	if o.Tol <= 0 {
+		o.Tol = 1e-12
+	}
+	if o.MaxIter <= 0 {
+		o.MaxIter = 100
+	}
+	return o
+}
+
+// Step to jedno przybliżenie: x, wartość f(x) i długość ostatniego kroku (lub szerokość przedziału).
+type Step struct {
+	Iter  int
+	X     float64
+	FX    float64
+	Delta float64
+}
+
+type Result struct {
+	Root       float64
+	Iterations int
+	Converged  bool
+	Trace      []Step
+}
+
+func (r *Result) add(x, fx, delta float64) {
+	r.Iterations++
+	r.Root = x
+	r.Trace = append(r.Trace, Step{r.Iterations, x, fx, delta})
+}
+
+/*
+Derivative liczy pochodną ilorazem różnicowym centralnym (f(x+h) - f(x-h)) / 2h.
+Krok h ∝ ∛ε jest kompromisem między błędem obcięcia (za duże h) a błędem zaokrągleń (za małe h).
+*/
+func Derivative(f Func, x float64) float64 {
+	h := math.Cbrt(2.2e-16) * math.Max(1, math.Abs(x))
+	return (f(x+h) - f(x-h)) / (2 * h)
+}
+
+/*
+Newton stosuje wzór x_{n+1} = x_n - f(x_n) / f'(x_n).
+Jeśli df == nil, pochodna jest liczona numerycznie funkcją Derivative.
+*/
+func Newton(f, df Func, x0 float64, opts Options) (Result, error) {
+	opts = opts.withDefaults()
+	if df == nil {
+		df = func(x float64) float64 { return Derivative(f, x) }
+	}
+
+	res := Result{Root: x0}
+	x := x0
+	for range opts.MaxIter {
+		fx := f(x)
+		if fx == 0 {
+			res.add(x, fx, 0)
+			res.Converged = true
+			return res, nil
+		}
+		d := df(x)
+		if d == 0 {
+			return res, ErrZeroDerivative
+		}
+		next := x - fx/d
+		delta := math.Abs(next - x)
+		res.add(next, f(next), delta)
+		x = next
+		if delta < opts.Tol*math.Max(1, math.Abs(next)) {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, ErrNoConvergence
+}
+
+// Secant to metoda siecznych startująca z punktów x0 i x1.
+func Secant(f Func, x0, x1 float64, opts Options) (Result, error) {
+	opts = opts.withDefaults()
+	res := Result{Root: x1}
+	f0, f1 := f(x0), f(x1)
+	for range opts.MaxIter {
+		if f1 == 0 {
+			res.add(x1, f1, 0)
+			res.Converged = true
+			return res, nil
+		}
+		if f1 == f0 {
+			return res, ErrZeroDerivative
+		}
+		x2 := x1 - f1*(x1-x0)/(f1-f0)
+		delta := math.Abs(x2 - x1)
+		x0, f0 = x1, f1
+		x1, f1 = x2, f(x2)
+		res.add(x1, f1, delta)
+		if delta < opts.Tol*math.Max(1, math.Abs(x1)) {
+			res.Converged = true
+			return res, nil
+		}
+	}
+	return res, ErrNoConvergence
+}
+
+// Bisection dzieli przedział [a, b] na pół, zachowując połowę, na której końcach f zmienia znak.
+func Bisection(f Func, a, b float64, opts Options) (Result, error) {
+	opts = opts.withDefaults()
+	fa, fb := f(a), f(b)
+	res := Result{Root: a}
+	switch {
+	case fa == 0:
+		res.add(a, fa, 0)
+		res.Converged = true
+		return res, nil
+	case fb == 0:
+		res.add(b, fb, 0)
+		res.Converged = true
+		return res, nil
+	case math.Signbit(fa) == math.Signbit(fb):
+		return res, ErrNotBracketed
+	}
+
+	for range opts.MaxIter {
+		mid := a + (b-a)/2
+		fm := f(mid)
+		res.add(mid, fm, math.Abs(b-a)/2)
+		if fm == 0 || math.Abs(b-a)/2 < opts.Tol {
+			res.Converged = true
+			return res, nil
+		}
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = mid, fm
+		} else {
+			b = mid
+		}
+	}
+	return res, ErrNoConvergence
+}
+
+/*
+Brent to metoda Brenta (wersja z "Numerical Recipes"): w każdym kroku próbuje interpolacji
+odwrotnej kwadratowej lub siecznych, a gdy krok byłby zbyt mały lub wychodziłby poza przedział,
+wraca do bisekcji. Dzięki temu zawsze pozostaje w przedziale zawierającym pierwiastek.
+*/
+func Brent(f Func, a, b float64, opts Options) (Result, error) {
+	opts = opts.withDefaults()
+	fa, fb := f(a), f(b)
+	res := Result{Root: b}
+	if fa == 0 || fb == 0 {
+		if fa == 0 {
+			b, fb = a, fa
+		}
+		res.add(b, fb, 0)
+		res.Converged = true
+		return res, nil
+	}
+	if math.Signbit(fa) == math.Signbit(fb) {
+		return res, ErrNotBracketed
+	}
+
+	c, fc := b, fb
+	var d, e float64
+	for range opts.MaxIter {
+		if math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*2.2e-16*math.Abs(b) + opts.Tol/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol || fb == 0 {
+			res.add(b, fb, math.Abs(m))
+			res.Converged = true
+			return res, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// Metoda siecznych.
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// Odwrotna interpolacja kwadratowa.
+				qq := fa / fc
+				r := fb / fc
+				p = s * (2*m*qq*(qq-r) - (b-a)*(r-1))
+				q = (qq - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = m
+				e = d
+			}
+		} else {
+			d = m
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+		res.add(b, fb, math.Abs(d))
+	}
+	return res, ErrNoConvergence
+}
@@ -0,0 +1,163 @@
+package numeric
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// close mówi, czy got i want różnią się najwyżej o tol względnie (albo bezwzględnie dla małych liczb).
+func close(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Max(1, math.Abs(want))
+}
+
+func FuzzSqrt(f *testing.F) {
+	for _, x := range []float64{0, 1, 2, 4, -4, 0.25, 1e-300, 5e-324, 1e300, math.MaxFloat64, math.Inf(1), math.NaN()} {
+		f.Add(x)
+	}
+	f.Fuzz(func(t *testing.T, x float64) {
+		got := Sqrt(x)
+		want := complex(math.Sqrt(x), 0)
+		if x < 0 {
+			want = complex(0, math.Sqrt(-x))
+		}
+		switch {
+		case math.IsNaN(x):
+			if !math.IsNaN(real(got)) {
+				t.Errorf("Sqrt(NaN) = %v, want NaN", got)
+			}
+		case math.IsInf(x, 0):
+			if got != want {
+				t.Errorf("Sqrt(%v) = %v, want %v", x, got, want)
+			}
+		case !close(real(got), real(want), 1e-14) || !close(imag(got), imag(want), 1e-14):
+			t.Errorf("Sqrt(%v) = %v, want %v", x, got, want)
+		}
+	})
+}
+
+func FuzzNthRoot(f *testing.F) {
+	f.Add(8.0, uint8(3))
+	f.Add(-27.0, uint8(3))
+	f.Add(2.0, uint8(2))
+	f.Add(1e300, uint8(7))
+	f.Add(1e-300, uint8(5))
+	f.Add(0.5, uint8(10))
+	f.Fuzz(func(t *testing.T, x float64, n uint8) {
+		if math.IsNaN(x) || math.IsInf(x, 0) || n == 0 {
+			t.Skip()
+		}
+		res, err := NthRoot(x, int(n), Options{})
+		if x < 0 && n%2 == 0 {
+			if !errors.Is(err, ErrDomain) {
+				t.Errorf("NthRoot(%v, %d): err = %v, want ErrDomain", x, n, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("NthRoot(%v, %d): %v", x, n, err)
+		}
+		// math.Pow(x, 1/n) sam obarczony jest błędem zaokrąglenia 1/n, stąd luźniejsza tolerancja.
+		want := math.Copysign(math.Pow(math.Abs(x), 1/float64(n)), x)
+		if !close(res.Root, want, 1e-12) {
+			t.Errorf("NthRoot(%v, %d) = %v, want %v", x, n, res.Root, want)
+		}
+	})
+}
+
+func TestNthRootDomain(t *testing.T) {
+	for _, tc := range []struct {
+		x float64
+		n int
+	}{{4, 0}, {4, -1}, {-4, 2}, {-16, 4}} {
+		if res, err := NthRoot(tc.x, tc.n, Options{}); !errors.Is(err, ErrDomain) || !math.IsNaN(res.Root) {
+			t.Errorf("NthRoot(%v, %d) = %v, %v; want NaN, ErrDomain", tc.x, tc.n, res.Root, err)
+		}
+	}
+}
+
+// solver ujednolica sygnatury metod: Newton i Secant dostają punkty startowe a i b, pozostałe przedział [a, b].
+type solver struct {
+	name  string
+	solve func(f Func, a, b float64, opts Options) (Result, error)
+}
+
+var solvers = []solver{
+	{"Newton", func(f Func, a, _ float64, opts Options) (Result, error) { return Newton(f, nil, a, opts) }},
+	{"Secant", Secant},
+	{"Bisection", Bisection},
+	{"Brent", Brent},
+}
+
+func TestConvergence(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		f    Func
+		a, b float64
+		root float64
+	}{
+		{"x²-2", func(x float64) float64 { return x*x - 2 }, 1, 2, math.Sqrt2},
+		{"cos x - x", func(x float64) float64 { return math.Cos(x) - x }, 0, 1, 0.7390851332151607},
+		{"x³-2x-5", func(x float64) float64 { return x*x*x - 2*x - 5 }, 2, 3, 2.0945514815423265},
+		{"e^x-10", func(x float64) float64 { return math.Exp(x) - 10 }, 2, 3, math.Log(10)},
+		{"root at a", func(x float64) float64 { return x - 1 }, 1, 3, 1},
+	} {
+		for _, s := range solvers {
+			t.Run(s.name+"/"+tc.name, func(t *testing.T) {
+				res, err := s.solve(tc.f, tc.a, tc.b, Options{Tol: 1e-12})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !res.Converged || !close(res.Root, tc.root, 1e-9) {
+					t.Errorf("root = %v (converged %v), want %v", res.Root, res.Converged, tc.root)
+				}
+				if res.Iterations != len(res.Trace) {
+					t.Errorf("Iterations = %d, len(Trace) = %d", res.Iterations, len(res.Trace))
+				}
+			})
+		}
+	}
+}
+
+func TestBrentFasterThanBisection(t *testing.T) {
+	f := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	bis, _ := Bisection(f, 2, 3, Options{})
+	brent, _ := Brent(f, 2, 3, Options{})
+	if brent.Iterations >= bis.Iterations {
+		t.Errorf("Brent took %d iterations, Bisection %d", brent.Iterations, bis.Iterations)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	square := func(x float64) float64 { return x*x + 1 } // brak pierwiastków rzeczywistych
+	flat := func(x float64) float64 { return 3 }
+	slow := func(x float64) float64 { return x*x - 2 }
+	for _, tc := range []struct {
+		name string
+		run  func() (Result, error)
+		want error
+	}{
+		{"Bisection/no sign change", func() (Result, error) { return Bisection(square, -1, 1, Options{}) }, ErrNotBracketed},
+		{"Brent/no sign change", func() (Result, error) { return Brent(square, -1, 1, Options{}) }, ErrNotBracketed},
+		{"Newton/zero derivative", func() (Result, error) { return Newton(square, nil, 0, Options{}) }, ErrZeroDerivative},
+		{"Newton/explicit zero derivative", func() (Result, error) {
+			return Newton(flat, func(float64) float64 { return 0 }, 1, Options{})
+		}, ErrZeroDerivative},
+		{"Secant/flat", func() (Result, error) { return Secant(flat, 0, 1, Options{}) }, ErrZeroDerivative},
+		{"Newton/max iterations", func() (Result, error) { return Newton(slow, nil, 100, Options{MaxIter: 3}) }, ErrNoConvergence},
+		{"Newton/oscillation", func() (Result, error) { return Newton(square, nil, 0.5, Options{MaxIter: 50}) }, ErrNoConvergence},
+		{"Secant/max iterations", func() (Result, error) { return Secant(slow, 100, 99, Options{MaxIter: 3}) }, ErrNoConvergence},
+		{"Bisection/max iterations", func() (Result, error) { return Bisection(slow, 0, 100, Options{MaxIter: 5}) }, ErrNoConvergence},
+		{"Brent/max iterations", func() (Result, error) { return Brent(slow, 0, 100, Options{MaxIter: 2}) }, ErrNoConvergence},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.run()
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if res.Converged {
+				t.Error("Converged = true on error")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package numeric
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+/*
+NthRoot liczy pierwiastek n-tego stopnia metodą Newtona dla f(z) = zⁿ - x.
+Dla ujemnego x i nieparzystego n wynik jest ujemny, a dla parzystego n zwracany jest ErrDomain.
+*/
+func NthRoot(x float64, n int, opts Options) (Result, error) {
+	switch {
+	case n < 1:
+		return Result{Root: math.NaN()}, ErrDomain
+	case x == 0 || n == 1:
+		return Result{Root: x, Converged: true}, nil
+	case x < 0 && n%2 == 0:
+		return Result{Root: math.NaN()}, ErrDomain
+	case x < 0:
+		res, err := NthRoot(-x, n, opts)
+		res.Root = -res.Root
+		for i := range res.Trace {
+			res.Trace[i].X = -res.Trace[i].X
+			res.Trace[i].FX = -res.Trace[i].FX
+		}
+		return res, err
+	}
+
+	// Rozkładamy x = m·2^(k·n), gdzie m = frac·2^e, frac ∈ [0.5, 1), e ∈ [0, n). Pierwiastek z m liczymy Newtonem,
+	// a wynik mnożymy przez 2^k. Dzięki temu liczba iteracji nie zależy od rzędu wielkości x (1e300 liczy się tak samo szybko jak 3).
+	frac, exp := math.Frexp(x)
+	k := exp / n
+	if exp%n < 0 {
+		k--
+	}
+	e := exp - k*n
+	m := math.Ldexp(frac, e)
+
+	nf := float64(n)
+	f := func(z float64) float64 { return math.Pow(z, nf) - m }
+	df := func(z float64) float64 { return nf * math.Pow(z, nf-1) }
+
+	// Start z 2^(e/n), czyli tuż powyżej pierwiastka (bo frac < 1): dla funkcji wypukłej Newton zbiega wtedy monotonicznie.
+	// Start z m dla dużego n (np. m ≈ 2⁶⁰ przy n = 61) wymagał tysięcy kroków, bo każdy zmniejsza x tylko o czynnik (n-1)/n.
+	res, err := Newton(f, df, math.Exp2(float64(e)/nf), opts)
+	res.Root = math.Ldexp(res.Root, k)
+	for i := range res.Trace {
+		res.Trace[i].X = math.Ldexp(res.Trace[i].X, k)
+		res.Trace[i].FX = math.Ldexp(res.Trace[i].FX, k*n)
+		res.Trace[i].Delta = math.Ldexp(res.Trace[i].Delta, k)
+	}
+	return res, err
+}
+
+/*
+Sqrt zwraca pierwiastek kwadratowy jako liczbę zespoloną, dzięki czemu działa także dla liczb ujemnych:
+Sqrt(-4) = 2i. Dla nieskończoności i NaN zachowuje się jak cmplx.Sqrt.
+*/
+func Sqrt(x float64) complex128 {
+	if math.IsInf(x, 0) || math.IsNaN(x) {
+		return cmplx.Sqrt(complex(x, 0))
+	}
+	res, _ := NthRoot(math.Abs(x), 2, Options{})
+	if x < 0 {
+		return complex(0, res.Root)
+	}
+	return complex(res.Root, 0)
+}
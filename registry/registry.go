@@ -0,0 +1,236 @@
+/*
+Pakiet registry przechowuje komponenty zarejestrowane pod nazwami i zarządza ich cyklem życia.
+
+Komponent może być wartością dowolnego typu. To, co potrafi, rejestr odkrywa w czasie wykonania
+asercjami typu na interfejsy opcjonalne: fmt.Stringer, io.Closer, Validator, Initializer, Starter i Stopper.
+Typ nie deklaruje, że je implementuje - wystarczy, że ma odpowiednie metody.
+
+Start uruchamia komponenty w kolejności zależności (najpierw te, od których inne zależą): Validate, Init, Start.
+Stop zatrzymuje je w odwrotnej kolejności: Stop, a potem Close.
+*/
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Validator sprawdza poprawność komponentu przed uruchomieniem.
+type Validator interface {
+	Validate() error
+}
+
+// Initializer przygotowuje komponent (np. tworzy mapy) przed Start.
+type Initializer interface {
+	Init() error
+}
+
+// Starter uruchamia komponent.
+type Starter interface {
+	Start() error
+}
+
+// Stopper zatrzymuje komponent uruchomiony przez Start.
+type Stopper interface {
+	Stop() error
+}
+
+var (
+	ErrDuplicate = errors.New("registry: duplicate component")
+	ErrUnknown   = errors.New("registry: unknown component")
+	ErrCycle     = errors.New("registry: dependency cycle")
+)
+
+type entry struct {
+	name        string
+	component   any
+	deps        []string
+	initialized bool // przeszedł krok init, więc może trzymać zasoby do zwolnienia przez Close
+	started     bool
+}
+
+/*
+Registry to rejestr komponentów. Hook, jeśli ustawiony, jest wywoływany po każdym kroku cyklu życia
+(np. "init", "start") z jego wynikiem - przydaje się do logowania.
+*/
+type Registry struct {
+	Hook    func(name, step string, err error)
+	entries []*entry
+	byName  map[string]*entry
+}
+
+func New() *Registry {
+	return &Registry{byName: map[string]*entry{}}
+}
+
+/*
+Register dodaje komponent name, który zależy od komponentów deps. Zależności nie muszą być jeszcze zarejestrowane -
+są sprawdzane dopiero przy Order i Start, więc kolejność wywołań Register nie ma znaczenia.
+*/
+func (r *Registry) Register(name string, component any, deps ...string) error {
+	if component == nil {
+		return fmt.Errorf("registry: component %q is nil", name)
+	}
+	if _, ok := r.byName[name]; ok {
+		return fmt.Errorf("%w %q", ErrDuplicate, name)
+	}
+	e := &entry{name: name, component: component, deps: deps}
+	r.entries = append(r.entries, e)
+	r.byName[name] = e
+	return nil
+}
+
+// Get zwraca komponent zarejestrowany pod nazwą name.
+func (r *Registry) Get(name string) (any, bool) {
+	e, ok := r.byName[name]
+	if !ok {
+		return nil, false
+	}
+	return e.component, true
+}
+
+/*
+Lookup zwraca komponent name jako typ T, np. Lookup[io.Closer](r, "db").
+Zwraca false, jeśli komponentu nie ma albo nie jest typu T.
+*/
+func Lookup[T any](r *Registry, name string) (T, bool) {
+	c, _ := r.Get(name)
+	t, ok := c.(T)
+	return t, ok
+}
+
+/*
+Order zwraca nazwy komponentów w kolejności zależności (sortowanie topologiczne przeszukiwaniem w głąb).
+Komponenty niezależne od siebie zachowują kolejność rejestracji, więc wynik jest powtarzalny.
+Cykl jest zgłaszany razem z jego ścieżką, np. "a -> b -> a".
+*/
+func (r *Registry) Order() ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	var order, path []string
+	var visit func(e *entry) error
+	visit = func(e *entry) error {
+		switch state[e.name] {
+		case done:
+			return nil
+		case visiting:
+			start := slices.Index(path, e.name)
+			return fmt.Errorf("%w: %s", ErrCycle, strings.Join(append(path[start:], e.name), " -> "))
+		}
+		state[e.name] = visiting
+		path = append(path, e.name)
+		for _, dep := range e.deps {
+			d, ok := r.byName[dep]
+			if !ok {
+				return fmt.Errorf("%w %q (dependency of %q)", ErrUnknown, dep, e.name)
+			}
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[e.name] = done
+		order = append(order, e.name)
+		return nil
+	}
+	for _, e := range r.entries {
+		if err := visit(e); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+/*
+Start waliduje, inicjalizuje i uruchamia komponenty w kolejności zależności. Jeśli któryś krok się nie powiedzie,
+wywoływane jest Stop: już uruchomione komponenty są zatrzymywane, a zainicjalizowane - także ten, którego Start
+się nie udał - zamykane. Zwracany błąd zawiera nazwę komponentu i krok.
+*/
+func (r *Registry) Start() error {
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+	for _, name := range order {
+		e := r.byName[name]
+		if err := r.startOne(e); err != nil {
+			return errors.Join(err, r.Stop())
+		}
+	}
+	return nil
+}
+
+func (r *Registry) startOne(e *entry) error {
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"validate", nil},
+		{"init", nil},
+		{"start", nil},
+	}
+	if v, ok := e.component.(Validator); ok {
+		steps[0].fn = v.Validate
+	}
+	if i, ok := e.component.(Initializer); ok {
+		steps[1].fn = i.Init
+	}
+	if s, ok := e.component.(Starter); ok {
+		steps[2].fn = s.Start
+	}
+	for _, step := range steps {
+		if step.fn != nil {
+			if err := r.run(e.name, step.name, step.fn); err != nil {
+				return err
+			}
+		}
+		if step.name == "init" {
+			e.initialized = true
+		}
+	}
+	e.started = true
+	return nil
+}
+
+/*
+Stop zatrzymuje komponenty w odwrotnej kolejności uruchamiania: Stop wywołuje dla uruchomionych, a Close dla
+zainicjalizowanych, nawet jeśli nigdy nie wystartowały (np. gdy ich Start zwrócił błąd) - inaczej zasoby
+przygotowane w Init nigdy nie zostałyby zwolnione. Błędy nie przerywają zatrzymywania pozostałych komponentów -
+są zbierane i zwracane razem.
+*/
+func (r *Registry) Stop() error {
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range slices.Backward(order) {
+		e := r.byName[name]
+		if s, ok := e.component.(Stopper); ok && e.started {
+			errs = append(errs, r.run(name, "stop", s.Stop))
+		}
+		if c, ok := e.component.(io.Closer); ok && e.initialized {
+			errs = append(errs, r.run(name, "close", c.Close))
+		}
+		e.started, e.initialized = false, false
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Registry) run(name, step string, fn func() error) error {
+	err := fn()
+	if r.Hook != nil {
+		r.Hook(name, step, err)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s: %w", name, step, err)
+	}
+	return nil
+}
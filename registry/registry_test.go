@@ -0,0 +1,135 @@
+package registry
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// component zapisuje wywołania metod cyklu życia we wspólnym dzienniku; fail to krok, który ma zwrócić błąd.
+type component struct {
+	name string
+	fail string
+	log  *[]string
+}
+
+func (c *component) call(step string) error {
+	*c.log = append(*c.log, c.name+"."+step)
+	if step == c.fail {
+		return errors.New(step + " failed")
+	}
+	return nil
+}
+
+func (c *component) Init() error  { return c.call("init") }
+func (c *component) Start() error { return c.call("start") }
+func (c *component) Stop() error  { return c.call("stop") }
+func (c *component) Close() error { return c.call("close") }
+
+// closeOnly nie ma Init ani Start, ale trzyma zasób zwalniany przez Close.
+type closeOnly struct {
+	log *[]string
+}
+
+func (c *closeOnly) Close() error {
+	*c.log = append(*c.log, "file.close")
+	return nil
+}
+
+func newRegistry(t *testing.T, log *[]string, fail map[string]string) *Registry {
+	t.Helper()
+	r := New()
+	for _, c := range []struct {
+		name string
+		deps []string
+	}{{"db", nil}, {"cache", []string{"db"}}, {"api", []string{"cache"}}} {
+		if err := r.Register(c.name, &component{c.name, fail[c.name], log}, c.deps...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return r
+}
+
+func TestStartStop(t *testing.T) {
+	var log []string
+	r := newRegistry(t, &log, nil)
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"db.init", "db.start", "cache.init", "cache.start", "api.init", "api.start",
+		"api.stop", "api.close", "cache.stop", "cache.close", "db.stop", "db.close",
+	}
+	if !slices.Equal(log, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", log, want)
+	}
+
+	log = nil
+	if err := r.Stop(); err != nil || len(log) != 0 {
+		t.Errorf("second Stop: %v, calls %v", err, log)
+	}
+}
+
+// TestStartFailureClosesInitialized sprawdza, że komponent, którego Start zawiódł, jest zamykany, choć nie jest zatrzymywany.
+func TestStartFailureClosesInitialized(t *testing.T) {
+	var log []string
+	r := newRegistry(t, &log, map[string]string{"cache": "start"})
+	err := r.Start()
+	if err == nil || err.Error() != "cache: start: start failed" {
+		t.Fatalf("Start() = %v", err)
+	}
+	want := []string{
+		"db.init", "db.start", "cache.init", "cache.start",
+		"cache.close", "db.stop", "db.close",
+	}
+	if !slices.Equal(log, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", log, want)
+	}
+}
+
+func TestInitFailureDoesNotClose(t *testing.T) {
+	var log []string
+	r := newRegistry(t, &log, map[string]string{"cache": "init"})
+	if err := r.Start(); err == nil {
+		t.Fatal("Start() = nil")
+	}
+	want := []string{"db.init", "db.start", "cache.init", "db.stop", "db.close"}
+	if !slices.Equal(log, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", log, want)
+	}
+}
+
+func TestCloseWithoutInit(t *testing.T) {
+	var log []string
+	r := New()
+	r.Register("file", &closeOnly{&log})
+	r.Register("api", &component{"api", "start", &log}, "file")
+	if err := r.Start(); err == nil {
+		t.Fatal("Start() = nil")
+	}
+	want := []string{"api.init", "api.start", "api.close", "file.close"}
+	if !slices.Equal(log, want) {
+		t.Errorf("calls:\n%v\nwant:\n%v", log, want)
+	}
+}
+
+func TestOrderErrors(t *testing.T) {
+	r := New()
+	r.Register("a", 1, "b")
+	r.Register("b", 2, "a")
+	if _, err := r.Order(); !errors.Is(err, ErrCycle) || err.Error() != "registry: dependency cycle: a -> b -> a" {
+		t.Errorf("Order() = %v", err)
+	}
+
+	r = New()
+	r.Register("a", 1, "missing")
+	if err := r.Start(); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Start() = %v, want ErrUnknown", err)
+	}
+	if err := r.Register("a", 2); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Register duplicate = %v, want ErrDuplicate", err)
+	}
+}
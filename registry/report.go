@@ -0,0 +1,78 @@
+package registry
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// capabilities to interfejsy opcjonalne w kolejności kolumn raportu.
+var capabilities = []struct {
+	name string
+	is   func(any) bool
+}{
+	{"Stringer", func(c any) bool { _, ok := c.(fmt.Stringer); return ok }},
+	{"Validator", func(c any) bool { _, ok := c.(Validator); return ok }},
+	{"Initializer", func(c any) bool { _, ok := c.(Initializer); return ok }},
+	{"Starter", func(c any) bool { _, ok := c.(Starter); return ok }},
+	{"Stopper", func(c any) bool { _, ok := c.(Stopper); return ok }},
+	{"Closer", func(c any) bool { _, ok := c.(io.Closer); return ok }},
+}
+
+/*
+Capabilities zwraca nazwy interfejsów opcjonalnych, które implementuje component.
+Wynik zależy od typu dynamicznego: T i *T mogą się różnić, bo metody z odbiorcą wskaźnikowym
+należą tylko do zbioru metod *T.
+*/
+func Capabilities(component any) []string {
+	var names []string
+	for _, c := range capabilities {
+		if c.is(component) {
+			names = append(names, c.name)
+		}
+	}
+	return names
+}
+
+/*
+Report wypisuje tabelę komponentów w kolejności zależności (albo rejestracji, jeśli zależności są błędne):
+
+	NAME     TYPE              DEPS    Stringer  Validator  ...
+	logger   *basics.logger    -       yes       -          ...
+*/
+func (r *Registry) Report(w io.Writer) error {
+	order, err := r.Order()
+	if err != nil {
+		order = order[:0]
+		for _, e := range r.entries {
+			order = append(order, e.name)
+		}
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprint(tw, "NAME\tTYPE\tDEPS")
+	for _, c := range capabilities {
+		fmt.Fprint(tw, "\t", c.name)
+	}
+	fmt.Fprintln(tw)
+	for _, name := range order {
+		e := r.byName[name]
+		deps := strings.Join(e.deps, ",")
+		if deps == "" {
+			deps = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%T\t%s", e.name, e.component, deps)
+		for _, c := range capabilities {
+			mark := "-"
+			if c.is(e.component) {
+				mark = "yes"
+			}
+			fmt.Fprint(tw, "\t", mark)
+		}
+		fmt.Fprintln(tw)
+	}
+	if flushErr := tw.Flush(); flushErr != nil {
+		return flushErr
+	}
+	return err
+}
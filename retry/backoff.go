@@ -0,0 +1,79 @@
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+/*
+Backoff wylicza, ile czekać przed kolejną próbą.
+attempt to numer nieudanej próby (od 1), a prev to poprzednie opóźnienie (0 przed pierwszym).
+*/
+type Backoff interface {
+	Delay(attempt int, prev time.Duration) time.Duration
+}
+
+// Constant czeka zawsze tyle samo.
+type Constant time.Duration
+
+func (c Constant) Delay(int, time.Duration) time.Duration {
+	return time.Duration(c)
+}
+
+/*
+Exponential zwiększa opóźnienie wykładniczo: Initial, Initial*Multiplier, Initial*Multiplier², ...
+aż do Max. Multiplier równy 0 oznacza podwajanie. Bez Max opóźnienie rośnie najwyżej do maxDelay.
+*/
+type Exponential struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+func (e Exponential) Delay(attempt int, _ time.Duration) time.Duration {
+	m := e.Multiplier
+	if m == 0 {
+		m = 2
+	}
+	d := float64(e.Initial) * math.Pow(m, float64(attempt-1))
+	if e.Max > 0 && d > float64(e.Max) {
+		return e.Max
+	}
+	// Przy wielu próbach d przekracza zakres int64 (albo jest +Inf), a konwersja takiej liczby na Duration
+	// daje dowolny wynik, w praktyce ujemne opóźnienie.
+	if d >= float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(d)
+}
+
+// maxDelay to największa wartość time.Duration, około 292 lat.
+const maxDelay = time.Duration(math.MaxInt64)
+
+/*
+DecorrelatedJitter losuje opóźnienie z przedziału [Base, 3*prev], ograniczone przez Cap.
+Losowość sprawia, że wielu klientów, którzy zawiedli jednocześnie, nie ponawia prób w tym samym momencie.
+Rand można podmienić, żeby wyniki były powtarzalne; domyślnie używany jest math/rand/v2.
+*/
+type DecorrelatedJitter struct {
+	Base time.Duration
+	Cap  time.Duration
+	Rand func() float64
+}
+
+func (j DecorrelatedJitter) Delay(_ int, prev time.Duration) time.Duration {
+	random := j.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	hi := 3 * prev
+	if hi < j.Base {
+		hi = j.Base
+	}
+	d := j.Base + time.Duration(random()*float64(hi-j.Base))
+	if j.Cap > 0 && d > j.Cap {
+		return j.Cap
+	}
+	return d
+}
@@ -0,0 +1,57 @@
+package retry
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+Clock oddziela logikę ponawiania od prawdziwego czasu.
+W programie używamy RealClock, a w testach i przykładach FakeClock, dzięki któremu "czekanie" trwa zero sekund.
+*/
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+var RealClock Clock = realClock{}
+
+// FakeClock przesuwa swój czas natychmiast przy każdym After i zapamiętuje, ile łącznie "przespano".
+type FakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.slept = append(c.slept, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// Slept zwraca kolejne opóźnienia, na które czekano.
+func (c *FakeClock) Slept() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration(nil), c.slept...)
+}
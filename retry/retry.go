@@ -0,0 +1,171 @@
+/*
+Pakiet retry ponawia operacje, które mogą się nie udać z przyczyn przejściowych
+(brak prądu, zerwane połączenie), zgodnie z zadaną polityką (Policy).
+
+Polityka określa opóźnienia między próbami (Backoff), limit prób lub czasu,
+klasyfikację błędów na przejściowe i trwałe oraz opcjonalny hook wywoływany po każdej próbie.
+*/
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrExhausted oznacza, że wyczerpano limit prób lub czasu.
+var ErrExhausted = errors.New("retry: attempts exhausted")
+
+type Class int
+
+const (
+	Retryable Class = iota
+	Permanent
+)
+
+// Classifier decyduje, czy po danym błędzie warto próbować ponownie.
+type Classifier func(err error) Class
+
+/*
+RetryOn ponawia tylko błędy pasujące (errors.Is) do jednego z podanych, np. RetryOn(ErrPower).
+Wszystkie inne błędy są trwałe.
+*/
+func RetryOn(targets ...error) Classifier {
+	return func(err error) Class {
+		for _, t := range targets {
+			if errors.Is(err, t) {
+				return Retryable
+			}
+		}
+		return Permanent
+	}
+}
+
+// PermanentOn jest odwrotnością RetryOn: podane błędy są trwałe, a pozostałe przejściowe.
+func PermanentOn(targets ...error) Classifier {
+	return func(err error) Class {
+		for _, t := range targets {
+			if errors.Is(err, t) {
+				return Permanent
+			}
+		}
+		return Retryable
+	}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// MarkPermanent pozwala samej operacji oznaczyć błąd jako trwały, niezależnie od klasyfikatora.
+func MarkPermanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err}
+}
+
+// Attempt opisuje zakończoną próbę i jest przekazywany do Policy.OnAttempt.
+type Attempt struct {
+	Number  int
+	Err     error
+	Class   Class
+	Delay   time.Duration // opóźnienie przed kolejną próbą; 0, gdy nie będzie kolejnej
+	Elapsed time.Duration
+}
+
+/*
+Policy opisuje sposób ponawiania. Wartości zerowe mają rozsądne znaczenie:
+brak Backoff to stałe 100ms, brak limitów to 3 próby, brak Classify to ponawianie każdego błędu,
+a brak Clock to prawdziwy zegar.
+*/
+type Policy struct {
+	Backoff     Backoff
+	MaxAttempts int
+	MaxElapsed  time.Duration
+	Classify    Classifier
+	OnAttempt   func(Attempt)
+	Clock       Clock
+}
+
+const defaultAttempts = 3
+
+/*
+Do wywołuje op, dopóki nie zwróci nil, błędu trwałego albo nie skończy się limit.
+Anulowanie ctx przerywa czekanie między próbami.
+*/
+func (p Policy) Do(ctx context.Context, op func(ctx context.Context) error) error {
+	backoff := p.Backoff
+	if backoff == nil {
+		backoff = Constant(100 * time.Millisecond)
+	}
+	classify := p.Classify
+	if classify == nil {
+		classify = func(error) Class { return Retryable }
+	}
+	clock := p.Clock
+	if clock == nil {
+		clock = RealClock
+	}
+	maxAttempts := p.MaxAttempts
+	if maxAttempts == 0 && p.MaxElapsed == 0 {
+		maxAttempts = defaultAttempts
+	}
+
+	start := clock.Now()
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := op(ctx)
+		if err == nil {
+			p.report(Attempt{Number: attempt, Elapsed: clock.Now().Sub(start)})
+			return nil
+		}
+
+		class := classify(err)
+		var pe *permanentError
+		if errors.As(err, &pe) {
+			class = Permanent
+		}
+
+		elapsed := clock.Now().Sub(start)
+		delay = backoff.Delay(attempt, delay)
+		exhausted := (maxAttempts > 0 && attempt >= maxAttempts) ||
+			(p.MaxElapsed > 0 && elapsed+delay > p.MaxElapsed)
+		if class == Permanent || exhausted {
+			p.report(Attempt{Number: attempt, Err: err, Class: class, Elapsed: elapsed})
+			if class == Permanent {
+				return err
+			}
+			return fmt.Errorf("%w after %d attempts: %w", ErrExhausted, attempt, err)
+		}
+
+		p.report(Attempt{Number: attempt, Err: err, Class: class, Delay: delay, Elapsed: elapsed})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("retry: %w; last error: %w", ctx.Err(), err)
+		case <-clock.After(delay):
+		}
+	}
+}
+
+func (p Policy) report(a Attempt) {
+	if p.OnAttempt != nil {
+		p.OnAttempt(a)
+	}
+}
+
+func (c Class) String() string {
+	if c == Permanent {
+		return "permanent"
+	}
+	return "retryable"
+}
@@ -0,0 +1,208 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+var (
+	errFlaky = errors.New("flaky")
+	errFatal = errors.New("fatal")
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// failing zwraca operację, która zawodzi n razy (z błędem err), a potem się udaje, oraz licznik wywołań.
+func failing(n int, err error) (func(context.Context) error, *int) {
+	calls := 0
+	return func(context.Context) error {
+		calls++
+		if calls <= n {
+			return err
+		}
+		return nil
+	}, &calls
+}
+
+func TestDelays(t *testing.T) {
+	fixed := func(vals ...float64) func() float64 {
+		return func() float64 {
+			v := vals[0]
+			vals = vals[1:]
+			return v
+		}
+	}
+	for _, tc := range []struct {
+		name    string
+		backoff Backoff
+		want    []time.Duration
+	}{
+		{"constant", Constant(50 * time.Millisecond), []time.Duration{50 * time.Millisecond, 50 * time.Millisecond, 50 * time.Millisecond, 50 * time.Millisecond}},
+		{"exponential", Exponential{Initial: 100 * time.Millisecond, Max: time.Second}, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}},
+		{"exponential x3", Exponential{Initial: time.Second, Multiplier: 3}, []time.Duration{time.Second, 3 * time.Second, 9 * time.Second}},
+		{
+			"decorrelated jitter",
+			DecorrelatedJitter{Base: 100 * time.Millisecond, Cap: time.Second, Rand: fixed(0, 0.5, 1, 1, 0)},
+			// [100ms, 100ms], [100ms, 300ms], [100ms, 600ms], [100ms, 1.8s] ograniczone do 1s, [100ms, 3s]
+			[]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 600 * time.Millisecond, time.Second, 100 * time.Millisecond},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := NewFakeClock(epoch)
+			op, _ := failing(len(tc.want), errFlaky)
+			p := Policy{Backoff: tc.backoff, MaxAttempts: len(tc.want) + 1, Clock: clock}
+			if err := p.Do(context.Background(), op); err != nil {
+				t.Fatal(err)
+			}
+			if got := clock.Slept(); !slices.Equal(got, tc.want) {
+				t.Errorf("slept %v, want %v", got, tc.want)
+			}
+			if got, want := clock.Now().Sub(epoch), sum(tc.want); got != want {
+				t.Errorf("clock advanced by %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func sum(ds []time.Duration) time.Duration {
+	var total time.Duration
+	for _, d := range ds {
+		total += d
+	}
+	return total
+}
+
+func TestExponentialDoesNotOverflow(t *testing.T) {
+	e := Exponential{Initial: time.Second}
+	prev := time.Duration(0)
+	for attempt := 1; attempt <= 2000; attempt++ {
+		d := e.Delay(attempt, prev)
+		if d < prev {
+			t.Fatalf("attempt %d: delay %v is smaller than previous %v", attempt, d, prev)
+		}
+		prev = d
+	}
+	if prev != maxDelay {
+		t.Errorf("delay after 2000 attempts = %v, want %v", prev, maxDelay)
+	}
+}
+
+func TestMaxAttempts(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		max, fail int
+		wantCalls int
+		exhausted bool
+	}{
+		{"default limit", 0, 10, defaultAttempts, true},
+		{"succeeds on last attempt", 4, 3, 4, false},
+		{"exhausted", 4, 10, 4, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := NewFakeClock(epoch)
+			op, calls := failing(tc.fail, errFlaky)
+			var attempts []Attempt
+			p := Policy{Backoff: Constant(time.Second), MaxAttempts: tc.max, Clock: clock, OnAttempt: func(a Attempt) { attempts = append(attempts, a) }}
+			err := p.Do(context.Background(), op)
+			if *calls != tc.wantCalls {
+				t.Errorf("op called %d times, want %d", *calls, tc.wantCalls)
+			}
+			if got := errors.Is(err, ErrExhausted); got != tc.exhausted {
+				t.Errorf("err = %v, exhausted %v, want %v", err, got, tc.exhausted)
+			}
+			if tc.exhausted && !errors.Is(err, errFlaky) {
+				t.Errorf("err = %v, want it to wrap the last error", err)
+			}
+			if len(attempts) != tc.wantCalls || attempts[len(attempts)-1].Delay != 0 {
+				t.Errorf("OnAttempt got %+v", attempts)
+			}
+			if got := len(clock.Slept()); got != tc.wantCalls-1 {
+				t.Errorf("slept %d times, want %d", got, tc.wantCalls-1)
+			}
+		})
+	}
+}
+
+func TestMaxElapsed(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	op, calls := failing(100, errFlaky)
+	p := Policy{Backoff: Constant(300 * time.Millisecond), MaxElapsed: time.Second, Clock: clock}
+	err := p.Do(context.Background(), op)
+	if !errors.Is(err, ErrExhausted) {
+		t.Fatalf("err = %v, want ErrExhausted", err)
+	}
+	// Po 3 próbach minęło 900ms, a kolejne czekanie przekroczyłoby limit 1s.
+	if *calls != 4 || clock.Now().Sub(epoch) != 900*time.Millisecond {
+		t.Errorf("calls = %d, elapsed %v; want 4 calls, 900ms", *calls, clock.Now().Sub(epoch))
+	}
+}
+
+func TestClassify(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		classify  Classifier
+		err       error
+		wantCalls int
+	}{
+		{"RetryOn matching", RetryOn(errFlaky), errFlaky, 3},
+		{"RetryOn other error", RetryOn(errFlaky), errFatal, 1},
+		{"PermanentOn matching", PermanentOn(errFatal), errFatal, 1},
+		{"PermanentOn other error", PermanentOn(errFatal), errFlaky, 3},
+		{"MarkPermanent overrides classifier", nil, MarkPermanent(errFlaky), 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			op, calls := failing(100, tc.err)
+			p := Policy{Classify: tc.classify, Clock: NewFakeClock(epoch)}
+			err := p.Do(context.Background(), op)
+			if *calls != tc.wantCalls {
+				t.Errorf("op called %d times, want %d", *calls, tc.wantCalls)
+			}
+			if !errors.Is(err, tc.err) {
+				t.Errorf("err = %v, want it to wrap %v", err, tc.err)
+			}
+			if tc.wantCalls == 1 && errors.Is(err, ErrExhausted) {
+				t.Errorf("permanent error reported as exhausted: %v", err)
+			}
+		})
+	}
+	if MarkPermanent(nil) != nil {
+		t.Error("MarkPermanent(nil) != nil")
+	}
+}
+
+// sleepingClock nigdy nie kończy czekania, tylko anuluje kontekst, jakby stało się to w trakcie snu.
+type sleepingClock struct {
+	*FakeClock
+	cancel context.CancelFunc
+}
+
+func (c sleepingClock) After(d time.Duration) <-chan time.Time {
+	c.cancel()
+	return make(chan time.Time)
+}
+
+func TestCancelDuringSleep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	op, calls := failing(100, errFlaky)
+	p := Policy{MaxAttempts: 5, Clock: sleepingClock{NewFakeClock(epoch), cancel}}
+	err := p.Do(ctx, op)
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errFlaky) {
+		t.Errorf("err = %v, want context.Canceled wrapping the last error", err)
+	}
+	if *calls != 1 {
+		t.Errorf("op called %d times, want 1", *calls)
+	}
+}
+
+func TestCancelledBeforeStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	op, calls := failing(0, nil)
+	if err := (Policy{Clock: NewFakeClock(epoch)}).Do(ctx, op); !errors.Is(err, context.Canceled) || *calls != 0 {
+		t.Errorf("err = %v, calls = %d; want context.Canceled and no calls", err, *calls)
+	}
+}
@@ -0,0 +1,119 @@
+package slicevis
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxCell ogranicza szerokość komórki diagramu; dłuższe wartości są skracane.
+const maxCell = 12
+
+/*
+Draw rysuje każdą śledzoną tablicę razem z oknami wycinków, które na nią wskazują:
+
+	array @0xc000060040: 4 × string (64 B)
+	          0      1      2      3
+	          John   XXX    George Ringo
+	names     [==========================]  len=4 cap=4
+	a         [=============|------------]  len=2 cap=4
+	b                [=============|-----]  len=2 cap=3
+
+"=" to elementy w zasięgu len, "-" to wolna pojemność, do której append zapisze bez realokacji.
+*/
+func (t *Tracker[T]) Draw(w io.Writer) error {
+	var b strings.Builder
+	label := 0
+	for _, name := range t.names {
+		label = max(label, utf8.RuneCountInString(name))
+	}
+	label += 2
+
+	for _, g := range t.groups() {
+		if g.start == g.end {
+			for _, name := range g.names {
+				s := t.slices[name]
+				state := "empty, no array"
+				if s == nil {
+					state = "nil, no array"
+				}
+				fmt.Fprintf(&b, "%s%s  len=%d cap=%d\n", pad(name, label), state, len(s), cap(s))
+			}
+			continue
+		}
+		t.drawGroup(&b, g, label)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (t *Tracker[T]) drawGroup(b *strings.Builder, g group, label int) {
+	n := int((g.end - g.start) / t.size)
+	values := make([]string, n)
+	for i := range values {
+		values[i] = "?"
+	}
+	// Wartości odczytujemy przez s[:cap(s)], bo elementy za len też należą do tablicy.
+	for _, name := range g.names {
+		s := t.slices[name]
+		off := t.offset(g, s)
+		for i, v := range s[:cap(s)] {
+			values[off+i] = shorten(fmt.Sprint(v))
+		}
+	}
+	cell := 1
+	for i, v := range values {
+		cell = max(cell, utf8.RuneCountInString(v), len(strconv.Itoa(i)))
+	}
+	cell++
+
+	var zero T
+	fmt.Fprintf(b, "array @%#x: %d × %T (%d B)\n", g.start, n, zero, uintptr(n)*t.size)
+	var index, contents strings.Builder
+	for i, v := range values {
+		index.WriteString(pad(strconv.Itoa(i), cell))
+		contents.WriteString(pad(v, cell))
+	}
+	indent := strings.Repeat(" ", label)
+	b.WriteString(indent + strings.TrimRight(index.String(), " ") + "\n")
+	b.WriteString(indent + strings.TrimRight(contents.String(), " ") + "\n")
+
+	for _, name := range g.names {
+		s := t.slices[name]
+		off := t.offset(g, s)
+		row := []rune(strings.Repeat(" ", n*cell))
+		for i := off * cell; i < (off+cap(s))*cell; i++ {
+			row[i] = '-'
+		}
+		for i := off * cell; i < (off+len(s))*cell; i++ {
+			row[i] = '='
+		}
+		row[off*cell] = '['
+		if len(s) > 0 && len(s) < cap(s) {
+			row[(off+len(s))*cell-1] = '|'
+		}
+		row[(off+cap(s))*cell-1] = ']'
+		fmt.Fprintf(b, "%s%s  len=%d cap=%d\n", pad(name, label), string(row), len(s), cap(s))
+	}
+}
+
+// offset zwraca indeks pierwszego elementu s w tablicy grupy.
+func (t *Tracker[T]) offset(g group, s []T) int {
+	return int((HeaderOf(s).Data - g.start) / t.size)
+}
+
+func pad(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+func shorten(s string) string {
+	if utf8.RuneCountInString(s) < maxCell {
+		return s
+	}
+	return string([]rune(s)[:maxCell-2]) + "…"
+}
@@ -0,0 +1,215 @@
+/*
+Pakiet slicevis pokazuje, jak wycinki dzielą tablice pod spodem i kiedy append przydziela nową tablicę.
+
+Wycinek to nagłówek: wskaźnik na pierwszy element, długość i pojemność. Dwa wycinki dzielą tablicę,
+jeśli ich zakresy [wskaźnik, wskaźnik+cap) nachodzą na siebie - porównujemy więc adresy z unsafe.SliceData.
+Tracker zapamiętuje nazwane wycinki, rejestruje wywołania append i rysuje diagram każdej tablicy w ASCII.
+*/
+package slicevis
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// Header to nagłówek wycinka: adres pierwszego elementu, długość i pojemność.
+type Header struct {
+	Data uintptr
+	Len  int
+	Cap  int
+}
+
+// HeaderOf odczytuje nagłówek wycinka. Dla wycinka nil Data wynosi 0.
+func HeaderOf[T any](s []T) Header {
+	return Header{uintptr(unsafe.Pointer(unsafe.SliceData(s))), len(s), cap(s)}
+}
+
+func (h Header) String() string {
+	return fmt.Sprintf("@%#x len=%d cap=%d", h.Data, h.Len, h.Cap)
+}
+
+// end zwraca adres tuż za ostatnim elementem dostępnym przez pojemność wycinka.
+func (h Header) end(size uintptr) uintptr {
+	return h.Data + uintptr(h.Cap)*size
+}
+
+// SameArray mówi, czy a i b wskazują na tę samą tablicę (zakresy ich pojemności nachodzą na siebie).
+func SameArray[T any](a, b []T) bool {
+	ha, hb := HeaderOf(a), HeaderOf(b)
+	size := elemSize[T]()
+	if ha.Cap == 0 || hb.Cap == 0 {
+		return false
+	}
+	return ha.Data < hb.end(size) && hb.Data < ha.end(size)
+}
+
+func elemSize[T any]() uintptr {
+	var zero T
+	if s := unsafe.Sizeof(zero); s > 0 {
+		return s
+	}
+	// Elementy o rozmiarze zero (np. struct{}) nie mają własnych adresów; traktujemy je jak 1 bajt.
+	return 1
+}
+
+/*
+Event opisuje jedno wywołanie append. Realloc oznacza, że pojemność się skończyła i append skopiował elementy
+do nowej tablicy - stare wycinki dalej wskazują na starą. Bez realokacji append pisze w miejscu, więc może nadpisać
+elementy widoczne przez inne wycinki tej samej tablicy; takie elementy trafiają do Overwrote, np. "b[1]".
+*/
+type Event struct {
+	Name      string
+	Added     int
+	Before    Header
+	After     Header
+	Realloc   bool
+	Overwrote []string
+}
+
+func (e Event) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s = append(%s, %d elem.): len %d→%d cap %d→%d", e.Name, e.Name, e.Added, e.Before.Len, e.After.Len, e.Before.Cap, e.After.Cap)
+	if e.Realloc {
+		fmt.Fprintf(&b, ", NEW ARRAY @%#x (copied %d elem.)", e.After.Data, e.Before.Len)
+	} else {
+		b.WriteString(", in place")
+	}
+	if len(e.Overwrote) > 0 {
+		fmt.Fprintf(&b, ", overwrote %s", strings.Join(e.Overwrote, " "))
+	}
+	return b.String()
+}
+
+// Tracker śledzi nazwane wycinki elementów typu T.
+type Tracker[T any] struct {
+	names  []string
+	slices map[string][]T
+	events []Event
+	size   uintptr
+}
+
+func NewTracker[T any]() *Tracker[T] {
+	return &Tracker[T]{slices: map[string][]T{}, size: elemSize[T]()}
+}
+
+// Track zapamiętuje (lub aktualizuje) wycinek pod daną nazwą i zwraca go bez zmian.
+func (t *Tracker[T]) Track(name string, s []T) []T {
+	if _, ok := t.slices[name]; !ok {
+		t.names = append(t.names, name)
+	}
+	t.slices[name] = s
+	return s
+}
+
+/*
+Append działa jak wbudowany append, ale przed i po porównuje nagłówki, rejestruje Event
+i aktualizuje wycinek name. Użycie: a = tr.Append("a", a, 1, 2).
+*/
+func (t *Tracker[T]) Append(name string, s []T, vals ...T) []T {
+	before := HeaderOf(s)
+	// Sprawdzamy przed append, bo po nim wartości w tablicy będą już nadpisane.
+	var overwrote []string
+	if len(s)+len(vals) <= cap(s) {
+		from := before.Data + uintptr(before.Len)*t.size
+		to := from + uintptr(len(vals))*t.size
+		overwrote = t.visibleIn(name, from, to)
+	}
+	res := append(s, vals...)
+	after := HeaderOf(res)
+	t.events = append(t.events, Event{
+		Name:      name,
+		Added:     len(vals),
+		Before:    before,
+		After:     after,
+		Realloc:   after.Cap > 0 && (before.Cap == 0 || after.Data != before.Data),
+		Overwrote: overwrote,
+	})
+	return t.Track(name, res)
+}
+
+// visibleIn zwraca elementy innych wycinków (w ramach ich len), które leżą pod adresami [from, to).
+func (t *Tracker[T]) visibleIn(skip string, from, to uintptr) []string {
+	var hits []string
+	for _, name := range t.names {
+		if name == skip {
+			continue
+		}
+		h := HeaderOf(t.slices[name])
+		for i := 0; i < h.Len; i++ {
+			if a := h.Data + uintptr(i)*t.size; a >= from && a < to {
+				hits = append(hits, fmt.Sprintf("%s[%d]", name, i))
+			}
+		}
+	}
+	return hits
+}
+
+// Events zwraca zarejestrowane wywołania Append w kolejności.
+func (t *Tracker[T]) Events() []Event {
+	return t.events
+}
+
+// Get zwraca ostatnio zapamiętany wycinek o danej nazwie.
+func (t *Tracker[T]) Get(name string) []T {
+	return t.slices[name]
+}
+
+/*
+Groups dzieli śledzone wycinki na grupy dzielące tę samą tablicę, w kolejności ich rejestracji.
+Wycinki bez tablicy (nil lub cap 0) są w osobnych, jednoelementowych grupach.
+*/
+func (t *Tracker[T]) Groups() [][]string {
+	var out [][]string
+	for _, g := range t.groups() {
+		out = append(out, g.names)
+	}
+	return out
+}
+
+type group struct {
+	names      []string
+	start, end uintptr // zakres adresów tablicy widoczny przez wycinki grupy; start == end dla wycinków bez tablicy
+}
+
+func (g group) overlaps(start, end uintptr) bool {
+	return g.start < g.end && start < g.end && g.start < end
+}
+
+func (t *Tracker[T]) groups() []group {
+	var groups []group
+	for _, name := range t.names {
+		h := HeaderOf(t.slices[name])
+		if h.Cap == 0 {
+			groups = append(groups, group{names: []string{name}})
+			continue
+		}
+		// Nowy wycinek może połączyć kilka dotychczasowych grup w jedną.
+		start, end := h.Data, h.end(t.size)
+		for _, g := range groups {
+			if g.overlaps(start, end) {
+				start, end = min(start, g.start), max(end, g.end)
+			}
+		}
+		var out []group
+		merged := -1
+		for _, g := range groups {
+			if !g.overlaps(start, end) {
+				out = append(out, g)
+				continue
+			}
+			if merged < 0 {
+				merged = len(out)
+				out = append(out, group{start: start, end: end})
+			}
+			out[merged].names = append(out[merged].names, g.names...)
+		}
+		if merged < 0 {
+			out = append(out, group{start: start, end: end})
+			merged = len(out) - 1
+		}
+		out[merged].names = append(out[merged].names, name)
+		groups = out
+	}
+	return groups
+}
@@ -0,0 +1,292 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Filter zwraca tabelę z wierszami, dla których keep zwraca true.
+func (t *Table) Filter(keep func(Row) bool) *Table {
+	out := New(t.Columns...)
+	for _, values := range t.Rows {
+		if keep(Row{t, values}) {
+			out.Rows = append(out.Rows, values)
+		}
+	}
+	return out
+}
+
+// Select zwraca tabelę z wybranymi kolumnami w podanej kolejności.
+func (t *Table) Select(columns ...string) (*Table, error) {
+	idx := make([]int, len(columns))
+	out := New()
+	for i, name := range columns {
+		j, err := t.index(name)
+		if err != nil {
+			return nil, err
+		}
+		idx[i] = j
+		out.Columns = append(out.Columns, t.Columns[j])
+	}
+	for _, values := range t.Rows {
+		row := make([]any, len(idx))
+		for i, j := range idx {
+			row[i] = values[j]
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out, nil
+}
+
+/*
+SortBy sortuje wiersze po kolejnych kolumnach; minus przed nazwą oznacza kolejność malejącą, np. SortBy("-sum(price)", "region").
+Sortowanie jest stabilne, a puste komórki trafiają zawsze na koniec.
+*/
+func (t *Table) SortBy(columns ...string) (*Table, error) {
+	type key struct {
+		col  int
+		desc bool
+	}
+	keys := make([]key, len(columns))
+	for i, name := range columns {
+		desc := strings.HasPrefix(name, "-")
+		j, err := t.index(strings.TrimPrefix(name, "-"))
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key{j, desc}
+	}
+	out := New(t.Columns...)
+	out.Rows = slices.Clone(t.Rows)
+	slices.SortStableFunc(out.Rows, func(a, b []any) int {
+		for _, k := range keys {
+			x, y := a[k.col], b[k.col]
+			switch {
+			case x == nil && y == nil:
+				continue
+			case x == nil:
+				return 1
+			case y == nil:
+				return -1
+			}
+			c := compare(x, y)
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return out, nil
+}
+
+// compare porównuje dwie niepuste komórki tej samej kolumny.
+func compare(a, b any) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		return cmpOrdered(a, b)
+	case float64:
+		return cmpOrdered(a, b.(float64))
+	case string:
+		return strings.Compare(a, b.(string))
+	case bool:
+		switch b := b.(bool); {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+func cmpOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+/*
+Agg to funkcja agregująca dla GroupBy. Tworzy się ją przez Count, Sum, Avg, Min albo Max.
+As zmienia nazwę kolumny wyniku, która domyślnie ma postać "sum(price)".
+*/
+type Agg struct {
+	Func   string
+	Column string
+	As     string
+}
+
+// Count liczy wiersze w grupie.
+func Count() Agg { return Agg{Func: "count"} }
+
+// Sum sumuje kolumnę liczbową; suma kolumny Int jest typu Int.
+func Sum(column string) Agg { return Agg{Func: "sum", Column: column} }
+
+// Avg liczy średnią kolumny liczbowej.
+func Avg(column string) Agg { return Agg{Func: "avg", Column: column} }
+
+// Min wybiera najmniejszą wartość kolumny dowolnego typu.
+func Min(column string) Agg { return Agg{Func: "min", Column: column} }
+
+// Max wybiera największą wartość kolumny dowolnego typu.
+func Max(column string) Agg { return Agg{Func: "max", Column: column} }
+
+// Named zwraca kopię agregatu z nazwą kolumny wyniku name.
+func (a Agg) Named(name string) Agg {
+	a.As = name
+	return a
+}
+
+func (a Agg) name() string {
+	switch {
+	case a.As != "":
+		return a.As
+	case a.Func == "count":
+		return "count"
+	}
+	return a.Func + "(" + a.Column + ")"
+}
+
+/*
+GroupBy dzieli wiersze na grupy o tych samych wartościach kolumn keys i liczy dla każdej grupy agregaty.
+Grupy są w kolejności pierwszego wystąpienia; do innej kolejności służy SortBy na wyniku.
+Bez kluczy cała tabela to jedna grupa, np. t.GroupBy(nil, table.Sum("price")).
+Puste komórki są pomijane przez agregaty (Count liczy wszystkie wiersze), a grupa bez żadnej wartości ma pusty wynik.
+*/
+func (t *Table) GroupBy(keys []string, aggs ...Agg) (*Table, error) {
+	out := New()
+	keyIdx := make([]int, len(keys))
+	for i, name := range keys {
+		j, err := t.index(name)
+		if err != nil {
+			return nil, err
+		}
+		keyIdx[i] = j
+		out.Columns = append(out.Columns, t.Columns[j])
+	}
+	aggIdx := make([]int, len(aggs))
+	for i, a := range aggs {
+		col, err := t.aggColumn(a)
+		if err != nil {
+			return nil, err
+		}
+		aggIdx[i] = -1
+		if a.Func != "count" {
+			aggIdx[i] = t.Index(a.Column)
+		}
+		out.Columns = append(out.Columns, col)
+	}
+
+	type group struct {
+		key  []any
+		rows [][]any
+	}
+	var groups []*group
+	byKey := map[string]*group{}
+	for _, values := range t.Rows {
+		key := make([]any, len(keyIdx))
+		var sb strings.Builder
+		for i, j := range keyIdx {
+			key[i] = values[j]
+			// %T odróżnia pustą komórkę od pustego tekstu, a \x00 nie występuje w zwykłych danych.
+			fmt.Fprintf(&sb, "%T:%v\x00", values[j], values[j])
+		}
+		g, ok := byKey[sb.String()]
+		if !ok {
+			g = &group{key: key}
+			byKey[sb.String()] = g
+			groups = append(groups, g)
+		}
+		g.rows = append(g.rows, values)
+	}
+	if len(keys) == 0 && len(groups) == 0 {
+		groups = append(groups, &group{}) // agregaty pustej tabeli: count = 0
+	}
+
+	for _, g := range groups {
+		row := slices.Clone(g.key)
+		for i, a := range aggs {
+			row = append(row, aggregate(a.Func, aggIdx[i], g.rows))
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out, nil
+}
+
+// aggColumn sprawdza, czy agregat pasuje do typu kolumny, i zwraca opis kolumny wyniku.
+func (t *Table) aggColumn(a Agg) (Column, error) {
+	if a.Func == "count" {
+		return Column{a.name(), Int}, nil
+	}
+	j, err := t.index(a.Column)
+	if err != nil {
+		return Column{}, err
+	}
+	typ := t.Columns[j].Type
+	switch a.Func {
+	case "sum", "avg":
+		if typ != Int && typ != Float {
+			return Column{}, fmt.Errorf("table: %s needs a numeric column, %q is %s", a.Func, a.Column, typ)
+		}
+		if a.Func == "avg" {
+			typ = Float
+		}
+	case "min", "max":
+	default:
+		return Column{}, fmt.Errorf("table: unknown aggregate %q", a.Func)
+	}
+	return Column{a.name(), typ}, nil
+}
+
+func aggregate(fn string, col int, rows [][]any) any {
+	if fn == "count" {
+		return int64(len(rows))
+	}
+	var (
+		best     any
+		isum     int64
+		fsum     float64
+		n        int
+		floating bool
+	)
+	for _, values := range rows {
+		v := values[col]
+		if v == nil {
+			continue
+		}
+		n++
+		switch v := v.(type) {
+		case int64:
+			isum += v
+			fsum += float64(v)
+		case float64:
+			fsum += v
+			floating = true
+		}
+		if best == nil || (fn == "min" && compare(v, best) < 0) || (fn == "max" && compare(v, best) > 0) {
+			best = v
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	switch fn {
+	case "sum":
+		if floating {
+			return fsum
+		}
+		return isum
+	case "avg":
+		return fsum / float64(n)
+	}
+	return best
+}
@@ -0,0 +1,208 @@
+package table
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const salesCSV = `city,product,qty,price,promo
+Kraków,kawa,3,24.99,nie
+Warszawa,herbata,10,12.50,tak
+Kraków,herbata,4,12.50,nie
+Gdańsk,kawa,1,26.00,nie
+Warszawa,kawa,7,24.99,tak
+Łódź,ciastka,12,,nie
+`
+
+func sales(t *testing.T) *Table {
+	t.Helper()
+	tab, err := ReadCSV(strings.NewReader(salesCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tab
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []string
+		aggs    []Agg
+		columns []Column
+		rows    [][]any
+	}{
+		{
+			"by city",
+			[]string{"city"},
+			[]Agg{Count(), Sum("qty"), Avg("price"), Min("price"), Max("product")},
+			[]Column{{"city", Text}, {"count", Int}, {"sum(qty)", Int}, {"avg(price)", Float}, {"min(price)", Float}, {"max(product)", Text}},
+			[][]any{
+				{"Kraków", int64(2), int64(7), 18.745, 12.5, "kawa"},
+				{"Warszawa", int64(2), int64(17), 18.745, 12.5, "kawa"},
+				{"Gdańsk", int64(1), int64(1), 26.0, 26.0, "kawa"},
+				{"Łódź", int64(1), int64(12), nil, nil, "ciastka"}, // pusta cena jest pomijana
+			},
+		},
+		{
+			"whole table",
+			nil,
+			[]Agg{Count().Named("orders"), Sum("price")},
+			[]Column{{"orders", Int}, {"sum(price)", Float}},
+			[][]any{{int64(6), 100.98}},
+		},
+		{
+			"two keys",
+			[]string{"promo", "product"},
+			[]Agg{Sum("qty")},
+			[]Column{{"promo", Bool}, {"product", Text}, {"sum(qty)", Int}},
+			[][]any{
+				{false, "kawa", int64(4)},
+				{true, "herbata", int64(10)},
+				{false, "herbata", int64(4)},
+				{true, "kawa", int64(7)},
+				{false, "ciastka", int64(12)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sales(t).GroupBy(tt.keys, tt.aggs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Columns, tt.columns) {
+				t.Errorf("columns = %v, want %v", got.Columns, tt.columns)
+			}
+			if len(got.Rows) != len(tt.rows) {
+				t.Fatalf("got %d rows, want %d:\n%s", len(got.Rows), len(tt.rows), got)
+			}
+			for r, row := range tt.rows {
+				for i, want := range row {
+					g := got.Rows[r][i]
+					if f, ok := want.(float64); ok {
+						if gf, ok := g.(float64); !ok || gf-f > 1e-9 || f-gf > 1e-9 {
+							t.Errorf("row %d, %s = %#v, want %v", r, got.Columns[i].Name, g, f)
+						}
+						continue
+					}
+					if g != want {
+						t.Errorf("row %d, %s = %#v, want %#v", r, got.Columns[i].Name, g, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGroupByEmpty(t *testing.T) {
+	empty := New(Column{"x", Int})
+	got, err := empty.GroupBy(nil, Count(), Sum("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]any{{int64(0), nil}}; !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("rows = %v, want %v", got.Rows, want)
+	}
+	got, err = empty.GroupBy([]string{"x"}, Count())
+	if err != nil || got.Len() != 0 {
+		t.Errorf("GroupBy by key on an empty table = %v rows, %v; want 0 rows", got.Len(), err)
+	}
+}
+
+func TestGroupByErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		agg  Agg
+		want string
+	}{
+		{"unknown key", []string{"nope"}, Count(), `unknown column "nope"`},
+		{"unknown column", nil, Sum("nope"), `unknown column "nope"`},
+		{"sum of text", nil, Sum("city"), `sum needs a numeric column, "city" is text`},
+		{"avg of bool", nil, Avg("promo"), `avg needs a numeric column, "promo" is bool`},
+		{"unknown aggregate", nil, Agg{Func: "median", Column: "qty"}, `unknown aggregate "median"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sales(t).GroupBy(tt.keys, tt.agg)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	tests := []struct {
+		columns []string
+		want    []string // kolumna city po sortowaniu
+	}{
+		{[]string{"qty"}, []string{"Gdańsk", "Kraków", "Kraków", "Warszawa", "Warszawa", "Łódź"}},
+		{[]string{"-qty"}, []string{"Łódź", "Warszawa", "Warszawa", "Kraków", "Kraków", "Gdańsk"}},
+		// Pusta cena Łodzi jest na końcu w obu kierunkach.
+		{[]string{"price", "city"}, []string{"Kraków", "Warszawa", "Kraków", "Warszawa", "Gdańsk", "Łódź"}},
+		{[]string{"-price", "city"}, []string{"Gdańsk", "Kraków", "Warszawa", "Kraków", "Warszawa", "Łódź"}},
+		{[]string{"promo", "-city"}, []string{"Łódź", "Kraków", "Kraków", "Gdańsk", "Warszawa", "Warszawa"}},
+		// Sortowanie jest stabilne: przy równych kluczach zostaje kolejność wejścia.
+		{[]string{"city"}, []string{"Gdańsk", "Kraków", "Kraków", "Warszawa", "Warszawa", "Łódź"}},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.columns, ","), func(t *testing.T) {
+			tab := sales(t)
+			got, err := tab.SortBy(tt.columns...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var cities []string
+			for _, row := range got.Rows {
+				cities = append(cities, row[0].(string))
+			}
+			if !reflect.DeepEqual(cities, tt.want) {
+				t.Errorf("order = %v, want %v", cities, tt.want)
+			}
+			if tab.Rows[0][0] != "Kraków" || tab.Rows[5][0] != "Łódź" {
+				t.Error("SortBy changed the original table")
+			}
+		})
+	}
+	if _, err := sales(t).SortBy("-nope"); err == nil {
+		t.Error("SortBy on an unknown column did not fail")
+	}
+}
+
+func TestSortByNilKeys(t *testing.T) {
+	tab := New(Column{"id", Int}, Column{"v", Float})
+	for _, row := range [][]any{{1, nil}, {2, 2.0}, {3, nil}, {4, 1.0}} {
+		tab.Append(row...)
+	}
+	for _, key := range []string{"v", "-v"} {
+		got, _ := tab.SortBy(key)
+		var ids []int64
+		for _, row := range got.Rows {
+			ids = append(ids, row[0].(int64))
+		}
+		want := []int64{4, 2, 1, 3}
+		if key == "-v" {
+			want = []int64{2, 4, 1, 3}
+		}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("SortBy(%q) ids = %v, want %v", key, ids, want)
+		}
+	}
+}
+
+func TestFilterSelect(t *testing.T) {
+	tab := sales(t).Filter(func(r Row) bool { return r.Get("promo") == true && r.Int("qty") >= 5 })
+	got, err := tab.Select("product", "city")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]any{{"herbata", "Warszawa"}, {"kawa", "Warszawa"}}
+	if !reflect.DeepEqual(got.Rows, want) {
+		t.Errorf("rows = %v, want %v", got.Rows, want)
+	}
+	if _, err := tab.Select("nope"); err == nil {
+		t.Error("Select of an unknown column did not fail")
+	}
+}
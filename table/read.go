@@ -0,0 +1,133 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadOptions opisuje format wejścia dla Read.
+type ReadOptions struct {
+	Comma rune            // separator pól; 0 oznacza ','
+	Types map[string]Type // wymuszone typy kolumn, np. kod pocztowy "00-950" albo identyfikator "007" jako Text
+}
+
+// ReadCSV wczytuje CSV z nagłówkiem w pierwszym wierszu.
+func ReadCSV(r io.Reader) (*Table, error) {
+	return Read(r, ReadOptions{})
+}
+
+// ReadTSV wczytuje dane rozdzielone tabulatorami (np. skopiowane z arkusza kalkulacyjnego).
+func ReadTSV(r io.Reader) (*Table, error) {
+	return Read(r, ReadOptions{Comma: '\t'})
+}
+
+/*
+Read wczytuje tabelę: pierwszy wiersz to nazwy kolumn, a typy kolumn są zgadywane z całej zawartości
+(stąd dane są najpierw czytane w całości jako tekst, a dopiero potem zamieniane na wartości).
+Wiersz o innej liczbie pól niż nagłówek to błąd z numerem linii.
+*/
+func Read(r io.Reader, opts ReadOptions) (*Table, error) {
+	cr := csv.NewReader(r)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("table: missing header")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("table: %w", err)
+	}
+	var records [][]string
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("table: %w", err)
+		}
+		records = append(records, rec)
+	}
+
+	t := &Table{Columns: make([]Column, len(header))}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		typ, ok := opts.Types[name]
+		if !ok {
+			typ = infer(records, i)
+		}
+		t.Columns[i] = Column{name, typ}
+	}
+	for n, rec := range records {
+		row := make([]any, len(rec))
+		for i, s := range rec {
+			v, err := parse(strings.TrimSpace(s), t.Columns[i].Type)
+			if err != nil {
+				return nil, fmt.Errorf("table: record %d, column %q: %w", n+1, t.Columns[i].Name, err)
+			}
+			row[i] = v
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t, nil
+}
+
+/*
+infer wybiera najwęższy typ, do którego pasują wszystkie niepuste komórki kolumny: Int, potem Float, potem Bool.
+Float to tylko zwykły zapis dziesiętny mieszczący się w float64 (bez NaN, Inf i zapisu szesnastkowego).
+Kolumna bez żadnej wartości jest typu Text.
+*/
+func infer(records [][]string, col int) Type {
+	candidates := []Type{Int, Float, Bool}
+	seen := false
+	for _, rec := range records {
+		s := strings.TrimSpace(rec[col])
+		if s == "" {
+			continue
+		}
+		seen = true
+		kept := candidates[:0]
+		for _, typ := range candidates {
+			if _, err := parse(s, typ); err == nil {
+				kept = append(kept, typ)
+			}
+		}
+		if candidates = kept; len(candidates) == 0 {
+			return Text
+		}
+	}
+	if !seen {
+		return Text
+	}
+	return candidates[0]
+}
+
+func parse(s string, typ Type) (any, error) {
+	if s == "" {
+		return nil, nil
+	}
+	switch typ {
+	case Int:
+		return strconv.ParseInt(s, 10, 64)
+	case Float:
+		// ParseFloat przyjmuje też "NaN", "Inf" czy "0x1p-2", więc kolumna imion z "Nan" stałaby się liczbowa.
+		if strings.Trim(s, "0123456789+-.eE") != "" {
+			return nil, fmt.Errorf("invalid float %q", s)
+		}
+		return strconv.ParseFloat(s, 64)
+	case Bool:
+		switch strings.ToLower(s) {
+		case "true", "yes", "tak":
+			return true, nil
+		case "false", "no", "nie":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid bool %q", s)
+	}
+	return s, nil
+}
@@ -0,0 +1,119 @@
+package table
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestInfer(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   Type
+	}{
+		{"ints", []string{"1", "-2", "30"}, Int},
+		{"ints and floats", []string{"1", "2.5", "-3e2"}, Float},
+		{"bools", []string{"tak", "nie", "TRUE", "no"}, Bool},
+		{"0 and 1 are ints", []string{"0", "1"}, Int},
+		{"text", []string{"1", "abc"}, Text},
+		{"empty cells are skipped", []string{"", "4", ""}, Int},
+		{"no values", []string{"", ""}, Text},
+		{"names like NaN", []string{"Nan", "Inf"}, Text},
+		{"infinity", []string{"1.5", "infinity"}, Text},
+		{"signed inf", []string{"+Inf", "-inf"}, Text},
+		{"hex float", []string{"0x1p-2"}, Text},
+		{"out of range", []string{"1e400"}, Text},
+		{"leading zeros", []string{"007", "010"}, Int},
+		{"postal code", []string{"00-950"}, Text},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := make([][]string, len(tt.values))
+			for i, v := range tt.values {
+				records[i] = []string{v}
+			}
+			got := infer(records, 0)
+			if got != tt.want {
+				t.Errorf("type of %q = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadValues(t *testing.T) {
+	const in = "name; price; ok; zip\nkawa; 24.99; tak; 00-950\nherbata; ; nie; 31-000\n"
+	tab, err := Read(strings.NewReader(in), ReadOptions{Comma: ';', Types: map[string]Type{"zip": Text}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]any{
+		{"kawa", 24.99, true, "00-950"},
+		{"herbata", nil, false, "31-000"},
+	}
+	for r, row := range want {
+		for i, v := range row {
+			if got := tab.Rows[r][i]; got != v {
+				t.Errorf("row %d, column %q = %#v, want %#v", r, tab.Columns[i].Name, got, v)
+			}
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts ReadOptions
+		want string
+	}{
+		{"no header", "", ReadOptions{}, "missing header"},
+		{"short row", "a,b\n1\n", ReadOptions{}, "wrong number of fields"},
+		{"forced type", "a\nx\n", ReadOptions{Types: map[string]Type{"a": Int}}, `record 1, column "a"`},
+		{"forced float rejects NaN", "a\nNaN\n", ReadOptions{Types: map[string]Type{"a": Float}}, `invalid float "NaN"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.in), tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Read error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestCSVRoundTrip sprawdza obietnicę z WriteCSV: zapisana tabela wczytuje się z tymi samymi typami i wartościami.
+func TestCSVRoundTrip(t *testing.T) {
+	tab := New(Column{"name", Text}, Column{"n", Int}, Column{"price", Float}, Column{"ok", Bool})
+	rows := [][]any{
+		{"a, \"quoted\"", 1, 1.0, true},
+		{"b", nil, -2.0, false},
+		{"c", -3, 1e21, nil},
+		{"d", 4, math.SmallestNonzeroFloat64, true},
+	}
+	for _, row := range rows {
+		if err := tab.Append(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var sb strings.Builder
+	if err := tab.WriteCSV(&sb); err != nil {
+		t.Fatal(err)
+	}
+	back, err := ReadCSV(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range tab.Columns {
+		if back.Columns[i] != c {
+			t.Errorf("column %d = %v, want %v\n%s", i, back.Columns[i], c, sb.String())
+		}
+	}
+	for r := range tab.Rows {
+		for i := range tab.Columns {
+			if got, want := back.Rows[r][i], tab.Rows[r][i]; got != want {
+				t.Errorf("row %d, column %q = %#v, want %#v", r, tab.Columns[i].Name, got, want)
+			}
+		}
+	}
+}
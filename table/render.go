@@ -0,0 +1,198 @@
+package table
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"lets-go/text"
+	"strings"
+)
+
+// FloatPrecision to liczba miejsc po przecinku liczb Float w formatach text i markdown. CSV i JSON zapisują pełną precyzję.
+const FloatPrecision = 2
+
+// Formats to nazwy formatów przyjmowanych przez Write.
+var Formats = []string{"text", "markdown", "csv", "json"}
+
+// Write zapisuje tabelę w formacie "text", "markdown", "csv" albo "json".
+func (t *Table) Write(w io.Writer, format string) error {
+	switch format {
+	case "text", "":
+		return t.WriteText(w)
+	case "markdown", "md":
+		return t.WriteMarkdown(w)
+	case "csv":
+		return t.WriteCSV(w)
+	case "json":
+		return t.WriteJSON(w)
+	}
+	return fmt.Errorf("table: unknown format %q (want %s)", format, strings.Join(Formats, ", "))
+}
+
+func (t *Table) String() string {
+	var sb strings.Builder
+	t.WriteText(&sb)
+	return sb.String()
+}
+
+/*
+cells formatuje nagłówek i wszystkie komórki do wyświetlenia i zwraca szerokości kolumn (w kolumnach terminala, nie w bajtach).
+escape (może być nil) zmienia tekst przed policzeniem szerokości, żeby dodane znaki nie rozjechały wyrównania.
+*/
+func (t *Table) cells(escape func(string) string) (header []string, cells [][]string, widths []int) {
+	if escape == nil {
+		escape = func(s string) string { return s }
+	}
+	header = make([]string, len(t.Columns))
+	widths = make([]int, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = escape(c.Name)
+		widths[i] = text.Width(header[i])
+	}
+	cells = make([][]string, len(t.Rows))
+	for r, values := range t.Rows {
+		cells[r] = make([]string, len(values))
+		for i, v := range values {
+			s := escape(format(v, FloatPrecision))
+			cells[r][i] = s
+			widths[i] = max(widths[i], text.Width(s))
+		}
+	}
+	return header, cells, widths
+}
+
+func (c Column) numeric() bool {
+	return c.Type == Int || c.Type == Float
+}
+
+/*
+WriteText zapisuje tabelę wyrównaną do szerokości kolumn; liczby są wyrównane do prawej:
+
+	region  count  sum(price)
+	------  -----  ----------
+	północ      2       30.50
+*/
+func (t *Table) WriteText(w io.Writer) error {
+	header, cells, widths := t.cells(nil)
+	bw := bufio.NewWriter(w)
+	line := func(values []string) {
+		padded := make([]string, len(values))
+		for i, s := range values {
+			if t.Columns[i].numeric() {
+				padded[i] = text.PadLeft(s, widths[i])
+			} else {
+				padded[i] = text.PadRight(s, widths[i])
+			}
+		}
+		bw.WriteString(strings.TrimRight(strings.Join(padded, "  "), " "))
+		bw.WriteByte('\n')
+	}
+	rule := make([]string, len(t.Columns))
+	for i := range t.Columns {
+		rule[i] = strings.Repeat("-", widths[i])
+	}
+	line(header)
+	line(rule)
+	for _, row := range cells {
+		line(row)
+	}
+	return bw.Flush()
+}
+
+/*
+WriteMarkdown zapisuje tabelę w formacie GitHub Markdown. Dwukropek w linii pod nagłówkiem wyrównuje liczby do prawej,
+a znaki | w komórkach są poprzedzane ukośnikiem, żeby nie rozbiły tabeli.
+*/
+func (t *Table) WriteMarkdown(w io.Writer) error {
+	header, cells, widths := t.cells(strings.NewReplacer("|", `\|`).Replace)
+	// Linia pod nagłówkiem potrzebuje co najmniej trzech znaków, więc węższe kolumny poszerzamy przed wyrównaniem komórek.
+	for i := range widths {
+		widths[i] = max(widths[i], 3)
+	}
+	bw := bufio.NewWriter(w)
+	line := func(values []string) {
+		bw.WriteString("|")
+		for i, s := range values {
+			if t.Columns[i].numeric() {
+				s = text.PadLeft(s, widths[i])
+			} else {
+				s = text.PadRight(s, widths[i])
+			}
+			bw.WriteString(" " + s + " |")
+		}
+		bw.WriteByte('\n')
+	}
+	line(header)
+	bw.WriteString("|")
+	for i, c := range t.Columns {
+		if c.numeric() {
+			bw.WriteString(" " + strings.Repeat("-", widths[i]-1) + ": |")
+		} else {
+			bw.WriteString(" " + strings.Repeat("-", widths[i]) + " |")
+		}
+	}
+	bw.WriteByte('\n')
+	for _, row := range cells {
+		line(row)
+	}
+	return bw.Flush()
+}
+
+/*
+WriteCSV zapisuje tabelę jako CSV z nagłówkiem; wynik można z powrotem wczytać przez ReadCSV.
+Typy Int, Float i Bool przetrwają taki obieg (Float zawsze ma kropkę, np. "1.0"), ale ReadCSV znów zgaduje typy:
+kolumna Text z wartościami wyglądającymi na liczby oraz kolumna bez żadnej wartości wymagają ReadOptions.Types.
+*/
+func (t *Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	cw.Write(header)
+	for _, values := range t.Rows {
+		rec := make([]string, len(values))
+		for i, v := range values {
+			rec[i] = format(v, -1)
+		}
+		cw.Write(rec)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+/*
+WriteJSON zapisuje tabelę jako tablicę obiektów, jeden obiekt na wiersz. Mapa w encoding/json sortuje klucze,
+dlatego obiekty są składane ręcznie - zachowują kolejność kolumn. Pusta komórka to null.
+*/
+func (t *Table) WriteJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("[")
+	for r, values := range t.Rows {
+		if r > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n  {")
+		for i, v := range values {
+			if i > 0 {
+				bw.WriteString(", ")
+			}
+			name, _ := json.Marshal(t.Columns[i].Name)
+			value, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("table: column %q: %w", t.Columns[i].Name, err) // np. NaN nie istnieje w JSON
+			}
+			bw.Write(name)
+			bw.WriteString(": ")
+			bw.Write(value)
+		}
+		bw.WriteString("}")
+	}
+	if len(t.Rows) > 0 {
+		bw.WriteString("\n")
+	}
+	bw.WriteString("]\n")
+	return bw.Flush()
+}
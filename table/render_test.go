@@ -0,0 +1,97 @@
+package table
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func small(t *testing.T) *Table {
+	t.Helper()
+	tab := New(Column{"id", Int}, Column{"n", Text}, Column{"żółw", Float}, Column{"ok", Bool})
+	for _, row := range [][]any{
+		{1, "a", 1.5, true},
+		{22, "b|c", nil, nil},
+		{3, "ąę", 1000.0, false},
+	} {
+		if err := tab.Append(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tab
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"text", `
+id  n       żółw  ok
+--  ---  -------  -----
+ 1  a       1.50  true
+22  b|c
+ 3  ąę   1000.00  false
+`},
+		// Kolumny węższe niż 3 znaki są poszerzane, zanim komórki zostaną wyrównane.
+		{"markdown", `
+|  id | n    |    żółw | ok    |
+| --: | ---- | ------: | ----- |
+|   1 | a    |    1.50 | true  |
+|  22 | b\|c |         |       |
+|   3 | ąę   | 1000.00 | false |
+`},
+		{"csv", `
+id,n,żółw,ok
+1,a,1.5,true
+22,b|c,,
+3,ąę,1000.0,false
+`},
+		{"json", `
+[
+  {"id": 1, "n": "a", "żółw": 1.5, "ok": true},
+  {"id": 22, "n": "b|c", "żółw": null, "ok": null},
+  {"id": 3, "n": "ąę", "żółw": 1000, "ok": false}
+]
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var sb strings.Builder
+			if err := small(t).Write(&sb, tt.format); err != nil {
+				t.Fatal(err)
+			}
+			if want := strings.TrimPrefix(tt.want, "\n"); sb.String() != want {
+				t.Errorf("Write(%q) =\n%s\nwant\n%s", tt.format, sb.String(), want)
+			}
+		})
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	tab := New(Column{"a", Text})
+	tests := map[string]string{
+		"text":     "a\n-\n",
+		"markdown": "| a   |\n| --- |\n",
+		"csv":      "a\n",
+		"json":     "[]\n",
+	}
+	for format, want := range tests {
+		var sb strings.Builder
+		if err := tab.Write(&sb, format); err != nil || sb.String() != want {
+			t.Errorf("Write(%q) = %q, %v; want %q", format, sb.String(), err, want)
+		}
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	var sb strings.Builder
+	if err := small(t).Write(&sb, "xml"); err == nil || !strings.Contains(err.Error(), `unknown format "xml"`) {
+		t.Errorf("Write(xml) error = %v", err)
+	}
+	tab := New(Column{"x", Float})
+	tab.Append(math.NaN())
+	if err := tab.WriteJSON(&sb); err == nil || !strings.Contains(err.Error(), `column "x"`) {
+		t.Errorf("WriteJSON(NaN) error = %v", err)
+	}
+}
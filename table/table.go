@@ -0,0 +1,229 @@
+/*
+Pakiet table wczytuje dane tabelaryczne (CSV, TSV) do kolumn z typami i tworzy z nich raporty.
+
+Typ każdej kolumny jest zgadywany z jej wartości: jeśli wszystkie niepuste komórki są liczbami całkowitymi,
+kolumna ma typ Int, jeśli liczbami - Float, jeśli wartościami logicznymi - Bool, a w przeciwnym razie Text.
+Pusta komórka to brak wartości (nil), który nie psuje typu kolumny i jest pomijany przez agregaty.
+
+Operacje (Filter, SortBy, GroupBy, Select) nie zmieniają tabeli, tylko zwracają nową, więc można je łączyć:
+
+	report, err := t.Filter(func(r table.Row) bool { return r.Float("price") > 10 }).
+		GroupBy([]string{"region"}, table.Sum("price"), table.Count())
+*/
+package table
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Type to typ wartości w kolumnie.
+type Type int
+
+const (
+	Text  Type = iota // string
+	Int               // int64
+	Float             // float64
+	Bool              // bool
+)
+
+func (t Type) String() string {
+	switch t {
+	case Text:
+		return "text"
+	case Int:
+		return "int"
+	case Float:
+		return "float"
+	case Bool:
+		return "bool"
+	}
+	return "Type(" + strconv.Itoa(int(t)) + ")"
+}
+
+// Column opisuje kolumnę tabeli.
+type Column struct {
+	Name string
+	Type Type
+}
+
+/*
+Table to kolumny i wiersze. Komórka ma typ zgodny z kolumną (string, int64, float64 lub bool) albo jest nil.
+*/
+type Table struct {
+	Columns []Column
+	Rows    [][]any
+}
+
+// New tworzy pustą tabelę z podanymi kolumnami.
+func New(columns ...Column) *Table {
+	return &Table{Columns: columns}
+}
+
+// Len zwraca liczbę wierszy.
+func (t *Table) Len() int {
+	return len(t.Rows)
+}
+
+// Index zwraca numer kolumny name albo -1, jeśli jej nie ma.
+func (t *Table) Index(name string) int {
+	for i, c := range t.Columns {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) index(name string) (int, error) {
+	i := t.Index(name)
+	if i < 0 {
+		return -1, fmt.Errorf("table: unknown column %q", name)
+	}
+	return i, nil
+}
+
+/*
+Append dodaje wiersz. Liczby całkowite dowolnego typu (int, int32, ...) są zamieniane na int64, a w kolumnie Float
+akceptowane są także liczby całkowite. Wartość innego typu niż kolumna to błąd.
+*/
+func (t *Table) Append(values ...any) error {
+	if len(values) != len(t.Columns) {
+		return fmt.Errorf("table: row has %d values, want %d", len(values), len(t.Columns))
+	}
+	row := make([]any, len(values))
+	for i, v := range values {
+		cell, err := convert(v, t.Columns[i].Type)
+		if err != nil {
+			return fmt.Errorf("table: column %q: %w", t.Columns[i].Name, err)
+		}
+		row[i] = cell
+	}
+	t.Rows = append(t.Rows, row)
+	return nil
+}
+
+func convert(v any, typ Type) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch typ {
+	case Text:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case Int:
+		if i, ok := toInt64(v); ok {
+			return i, nil
+		}
+	case Float:
+		if f, ok := v.(float64); ok {
+			return f, nil
+		}
+		if f, ok := v.(float32); ok {
+			return float64(f), nil
+		}
+		if i, ok := toInt64(v); ok {
+			return float64(i), nil
+		}
+	case Bool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("%v (%T) is not %s", v, v, typ)
+}
+
+func toInt64(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// Row to widok jednego wiersza, przekazywany do funkcji w Filter.
+type Row struct {
+	t      *Table
+	values []any
+}
+
+// Get zwraca wartość komórki w kolumnie name. Nieznana kolumna to błąd programisty, więc Get panikuje.
+func (r Row) Get(name string) any {
+	i := r.t.Index(name)
+	if i < 0 {
+		panic(fmt.Sprintf("table: unknown column %q", name))
+	}
+	return r.values[i]
+}
+
+// Float zwraca wartość liczbową komórki (Int jest zamieniany na float64); brak wartości i inne typy dają 0.
+func (r Row) Float(name string) float64 {
+	f, _ := toFloat(r.Get(name))
+	return f
+}
+
+// Int zwraca wartość komórki typu Int; brak wartości i inne typy dają 0.
+func (r Row) Int(name string) int64 {
+	i, _ := r.Get(name).(int64)
+	return i
+}
+
+// String zwraca komórkę sformatowaną tak jak w CSV; brak wartości to "".
+func (r Row) String(name string) string {
+	return format(r.Get(name), -1)
+}
+
+// IsNull mówi, czy komórka jest pusta.
+func (r Row) IsNull(name string) bool {
+	return r.Get(name) == nil
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+/*
+format zamienia komórkę na tekst. prec to liczba miejsc po przecinku dla Float (-1 oznacza najkrótszy zapis,
+który po wczytaniu daje tę samą liczbę; liczba całkowita dostaje wtedy ".0", żeby Read nie uznał kolumny za Int).
+*/
+func format(v any, prec int) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		s := strconv.FormatFloat(v, 'f', prec, 64)
+		if prec < 0 && !strings.ContainsAny(s, ".NI") { // NaN, +Inf i -Inf zostają bez zmian
+			s += ".0"
+		}
+		return s
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
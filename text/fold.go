@@ -0,0 +1,54 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+Fold sprowadza tekst do postaci do porównań bez względu na wielkość liter: normalizuje do NFC i zamienia litery na małe.
+Dzięki normalizacji "ŻÓŁW" zapisany w NFD i "żółw" w NFC dają ten sam wynik, czego strings.ToLower nie zapewnia.
+Polskie litery nie mają specjalnych reguł zmiany wielkości (jak tureckie i), więc wystarczają tabele unicode.
+*/
+func Fold(s string) string {
+	return strings.Map(unicode.ToLower, NFC(s))
+}
+
+// EqualFold porównuje napisy bez względu na wielkość liter i postać normalizacji.
+func EqualFold(a, b string) bool {
+	return Fold(a) == Fold(b)
+}
+
+// bareLetters to litery bez rozkładu kanonicznego, które StripDiacritics zamienia ręcznie.
+var bareLetters = map[rune]string{
+	'ł': "l", 'Ł': "L",
+	'đ': "d", 'Đ': "D",
+	'ø': "o", 'Ø': "O",
+	'ħ': "h", 'Ħ': "H",
+	'ŧ': "t", 'Ŧ': "T",
+	'ı': "i",
+	'ß': "ss",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+}
+
+/*
+StripDiacritics usuwa znaki diakrytyczne: "Wartość" → "Wartosc", "Zażółć gęślą jaźń" → "Zazolc gesla jazn".
+Litery rozkładalne (ą, ś, ó, ...) rozkładamy do NFD i pomijamy znaki łączące.
+Litera ł nie ma rozkładu (kreska jest częścią litery, a nie osobnym znakiem), dlatego obsługuje ją osobna tabela.
+*/
+func StripDiacritics(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range NFD(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if repl, ok := bareLetters[r]; ok {
+			b.WriteString(repl)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
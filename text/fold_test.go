@@ -0,0 +1,47 @@
+package text
+
+import "testing"
+
+func TestStripDiacritics(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"Wartość", "Wartosc"},
+		{"Wartośc\u0301", "Wartosc"},       // mieszane NFC i NFD
+		{"Wartos\u0301c\u0301", "Wartosc"}, // NFD
+		{"Zażółć gęślą jaźń", "Zazolc gesla jazn"},
+		{"ZAŻÓŁĆ GĘŚLĄ JAŹŃ", "ZAZOLC GESLA JAZN"},
+		{"Łódź", "Lodz"},
+		{"Straße, Ærø, Œuvre", "Strasse, AEro, OEuvre"},
+		{"naïve café", "naive cafe"},
+		{"日本", "日本"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := StripDiacritics(tt.s); got != tt.want {
+			t.Errorf("StripDiacritics(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"ŻÓŁW", "żółw"},
+		{"Z\u0307O\u0301ŁW", "żółw"}, // NFD daje ten sam wynik co NFC
+		{"Wartość", "wartość"},
+		{"ABC", "abc"},
+	}
+	for _, tt := range tests {
+		if got := Fold(tt.s); got != tt.want {
+			t.Errorf("Fold(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+	if !EqualFold("ZAŻÓŁĆ", "Zaz\u0307o\u0301łc\u0301") {
+		t.Error("EqualFold of NFC upper and NFD mixed case = false")
+	}
+	if EqualFold("żółw", "zolw") {
+		t.Error("EqualFold ignores diacritics")
+	}
+}
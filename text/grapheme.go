@@ -0,0 +1,201 @@
+/*
+Pakiet text zawiera narzędzia do pracy z tekstem Unicode, których brakuje w bibliotece standardowej:
+podział na grafemy (znaki widziane przez użytkownika), szerokość w terminalu, bezpieczne skracanie i odwracanie,
+normalizację NFC/NFD oraz porównywanie i usuwanie znaków diakrytycznych z uwzględnieniem języka polskiego.
+
+Tabele właściwości są przybliżeniem pełnych danych Unicode - obejmują pismo łacińskie, Hangul,
+znaki łączące i emoji, czyli to, czego używają lekcje. Nie zastępują pakietów z golang.org/x/text.
+*/
+package text
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// gbProp to właściwość Grapheme_Cluster_Break z UAX #29, od której zależą granice grafemów.
+type gbProp uint8
+
+const (
+	gbOther gbProp = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbSpacingMark
+	gbPrepend
+	gbL // Hangul: spółgłoska początkowa
+	gbV // Hangul: samogłoska
+	gbT // Hangul: spółgłoska końcowa
+	gbLV
+	gbLVT
+)
+
+const (
+	hangulBase  = 0xAC00
+	hangulCount = 11172
+	hangulLBase = 0x1100
+	hangulVBase = 0x1161
+	hangulTBase = 0x11A7
+	hangulVN    = 21
+	hangulTN    = 28
+)
+
+func gbProperty(r rune) gbProp {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200D:
+		return gbZWJ
+	case r == 0x200C, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F, r == 0xFF9E, r == 0xFF9F:
+		// ZWNJ, modyfikatory koloru skóry emoji i znaczniki flag (tag characters) też są rozszerzeniami.
+		return gbExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gbRegionalIndicator
+	case (r >= 0x0600 && r <= 0x0605) || r == 0x06DD || r == 0x070F || r == 0x0890 || r == 0x0891 || r == 0x08E2 || r == 0x110BD || r == 0x110CD:
+		return gbPrepend
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf):
+		return gbControl
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return gbL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return gbV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return gbT
+	case r >= hangulBase && r < hangulBase+hangulCount:
+		if (r-hangulBase)%hangulTN == 0 {
+			return gbLV
+		}
+		return gbLVT
+	}
+	return gbOther
+}
+
+// pictographic to przybliżenie właściwości Extended_Pictographic: bloki emoji i symboli.
+var pictographic = []struct{ lo, hi rune }{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x21AA}, {0x231A, 0x23FF},
+	{0x24C2, 0x24C2}, {0x25AA, 0x25FE}, {0x2600, 0x27BF}, {0x2934, 0x2935},
+	{0x2B05, 0x2B55}, {0x3030, 0x3030}, {0x303D, 0x303D}, {0x3297, 0x3299},
+	{0x1F000, 0x1F0FF}, {0x1F10D, 0x1F1AD}, {0x1F201, 0x1F3FA}, {0x1F400, 0x1FAFF},
+	{0x1FC00, 0x1FFFD},
+}
+
+func isPictographic(r rune) bool {
+	return inRanges(r, pictographic)
+}
+
+func inRanges(r rune, ranges []struct{ lo, hi rune }) bool {
+	for _, rg := range ranges {
+		if r < rg.lo {
+			return false
+		}
+		if r <= rg.hi {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+FirstGrapheme zwraca długość w bajtach pierwszego grafemu w s, stosując reguły GB3-GB13 z UAX #29:
+
+  - CR LF to jeden grafem, pozostałe znaki sterujące zawsze są osobno,
+  - sylaby Hangul składane z jamo (L, V, T) tworzą jeden grafem,
+  - znaki łączące, ZWJ i modyfikatory dołączają do poprzedniego znaku,
+  - emoji połączone ZWJ (np. 🙋🏽‍♀️) tworzą jeden grafem,
+  - wskaźniki regionalne łączą się w pary, czyli flagi (🇵🇱).
+*/
+func FirstGrapheme(s string) int {
+	if s == "" {
+		return 0
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	prev := gbProperty(r)
+	pict := isPictographic(r) // ciąg ExtPict Extend* przed bieżącym znakiem
+	zwjAfterPict := false     // ciąg ExtPict Extend* ZWJ
+	regional := 0             // liczba wskaźników regionalnych pod rząd
+	if prev == gbRegionalIndicator {
+		regional = 1
+	}
+	for size < len(s) {
+		r, n := utf8.DecodeRuneInString(s[size:])
+		cur := gbProperty(r)
+		if isBoundary(prev, cur, zwjAfterPict && isPictographic(r), regional) {
+			break
+		}
+		switch {
+		case isPictographic(r):
+			pict, zwjAfterPict = true, false
+		case cur == gbExtend:
+			zwjAfterPict = false
+		case cur == gbZWJ:
+			zwjAfterPict, pict = pict, false
+		default:
+			pict, zwjAfterPict = false, false
+		}
+		if cur == gbRegionalIndicator {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = cur
+		size += n
+	}
+	return size
+}
+
+func isBoundary(prev, cur gbProp, emojiZWJ bool, regional int) bool {
+	switch {
+	case prev == gbCR && cur == gbLF: // GB3
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		return true
+	case cur == gbControl || cur == gbCR || cur == gbLF: // GB5
+		return true
+	case prev == gbL && (cur == gbL || cur == gbV || cur == gbLV || cur == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (cur == gbV || cur == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && cur == gbT: // GB8
+		return false
+	case cur == gbExtend || cur == gbZWJ || cur == gbSpacingMark: // GB9, GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case prev == gbZWJ && emojiZWJ: // GB11
+		return false
+	case prev == gbRegionalIndicator && cur == gbRegionalIndicator: // GB12, GB13
+		return regional%2 == 0
+	}
+	return true // GB999
+}
+
+// Graphemes dzieli s na grafemy.
+func Graphemes(s string) []string {
+	var out []string
+	for s != "" {
+		n := FirstGrapheme(s)
+		out = append(out, s[:n])
+		s = s[n:]
+	}
+	return out
+}
+
+// GraphemeCount zwraca liczbę grafemów, czyli znaków, które widzi użytkownik.
+func GraphemeCount(s string) int {
+	count := 0
+	for s != "" {
+		s = s[FirstGrapheme(s):]
+		count++
+	}
+	return count
+}
@@ -0,0 +1,30 @@
+package text
+
+import "testing"
+
+func TestName(t *testing.T) {
+	for _, tc := range []struct {
+		r    rune
+		want string
+	}{
+		{'A', "LATIN CAPITAL LETTER A"},
+		{'ą', "LATIN SMALL LETTER A WITH OGONEK"},
+		{0x0301, "COMBINING ACUTE ACCENT"},
+		{'Ж', "CYRILLIC CAPITAL LETTER ZHE"},
+		{'λ', "GREEK SMALL LETTER LAMDA"},
+		{'א', "HEBREW LETTER ALEF"},
+		{'ک', "ARABIC LETTER KEHEH"},
+		{'한', "HANGUL SYLLABLE HAN"},
+		{'中', "CJK UNIFIED IDEOGRAPH-4E2D"},
+		{0x1F1F5, "REGIONAL INDICATOR SYMBOL LETTER P"},
+		{0xFE0F, "VARIATION SELECTOR-16"},
+		{'\n', "<control>"},
+		{0xE000, "<private-use>"},
+		{'क', UnknownName}, // dewanagari jest poza tabelą
+		{'ก', UnknownName},
+	} {
+		if got := Name(tc.r); got != tc.want {
+			t.Errorf("Name(%U) = %q, want %q", tc.r, got, tc.want)
+		}
+	}
+}
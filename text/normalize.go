@@ -0,0 +1,224 @@
+package text
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+/*
+Ta sama litera może być zapisana na dwa sposoby: "ą" jako jedna runa U+0105 (postać złożona, NFC)
+albo jako "a" i znak łączący ogonek U+0328 (postać rozłożona, NFD). Wyglądają identycznie, ale == je rozróżnia.
+
+Wbudowana tabela zawiera rozkłady kanoniczne liter z bloków Latin-1 Supplement i Latin Extended-A
+(w tym wszystkie polskie litery poza ł, które w Unicode nie ma rozkładu) oraz algorytmiczny rozkład sylab Hangul.
+Znaki spoza tabeli przechodzą bez zmian.
+*/
+
+// decompositions mapuje literę na literę bazową i znak łączący.
+var decompositions = map[rune][2]rune{
+	// U+0300 grawis
+	'À': {'A', 0x300}, 'È': {'E', 0x300}, 'Ì': {'I', 0x300}, 'Ò': {'O', 0x300}, 'Ù': {'U', 0x300}, 'à': {'a', 0x300},
+	'è': {'e', 0x300}, 'ì': {'i', 0x300}, 'ò': {'o', 0x300}, 'ù': {'u', 0x300},
+	// U+0301 akcent ostry (kreska)
+	'Á': {'A', 0x301}, 'É': {'E', 0x301}, 'Í': {'I', 0x301}, 'Ó': {'O', 0x301}, 'Ú': {'U', 0x301}, 'Ý': {'Y', 0x301},
+	'á': {'a', 0x301}, 'é': {'e', 0x301}, 'í': {'i', 0x301}, 'ó': {'o', 0x301}, 'ú': {'u', 0x301}, 'ý': {'y', 0x301},
+	'Ć': {'C', 0x301}, 'ć': {'c', 0x301}, 'Ĺ': {'L', 0x301}, 'ĺ': {'l', 0x301}, 'Ń': {'N', 0x301}, 'ń': {'n', 0x301},
+	'Ŕ': {'R', 0x301}, 'ŕ': {'r', 0x301}, 'Ś': {'S', 0x301}, 'ś': {'s', 0x301}, 'Ź': {'Z', 0x301}, 'ź': {'z', 0x301},
+	// U+0302 daszek
+	'Â': {'A', 0x302}, 'Ê': {'E', 0x302}, 'Î': {'I', 0x302}, 'Ô': {'O', 0x302}, 'Û': {'U', 0x302}, 'â': {'a', 0x302},
+	'ê': {'e', 0x302}, 'î': {'i', 0x302}, 'ô': {'o', 0x302}, 'û': {'u', 0x302}, 'Ĉ': {'C', 0x302}, 'ĉ': {'c', 0x302},
+	'Ĝ': {'G', 0x302}, 'ĝ': {'g', 0x302}, 'Ĥ': {'H', 0x302}, 'ĥ': {'h', 0x302}, 'Ĵ': {'J', 0x302}, 'ĵ': {'j', 0x302},
+	'Ŝ': {'S', 0x302}, 'ŝ': {'s', 0x302}, 'Ŵ': {'W', 0x302}, 'ŵ': {'w', 0x302}, 'Ŷ': {'Y', 0x302}, 'ŷ': {'y', 0x302},
+	// U+0303 tylda
+	'Ã': {'A', 0x303}, 'Ñ': {'N', 0x303}, 'Õ': {'O', 0x303}, 'ã': {'a', 0x303}, 'ñ': {'n', 0x303}, 'õ': {'o', 0x303},
+	'Ĩ': {'I', 0x303}, 'ĩ': {'i', 0x303}, 'Ũ': {'U', 0x303}, 'ũ': {'u', 0x303},
+	// U+0304 makron
+	'Ā': {'A', 0x304}, 'ā': {'a', 0x304}, 'Ē': {'E', 0x304}, 'ē': {'e', 0x304}, 'Ī': {'I', 0x304}, 'ī': {'i', 0x304},
+	'Ō': {'O', 0x304}, 'ō': {'o', 0x304}, 'Ū': {'U', 0x304}, 'ū': {'u', 0x304},
+	// U+0306 breve
+	'Ă': {'A', 0x306}, 'ă': {'a', 0x306}, 'Ĕ': {'E', 0x306}, 'ĕ': {'e', 0x306}, 'Ğ': {'G', 0x306}, 'ğ': {'g', 0x306},
+	'Ĭ': {'I', 0x306}, 'ĭ': {'i', 0x306}, 'Ŏ': {'O', 0x306}, 'ŏ': {'o', 0x306}, 'Ŭ': {'U', 0x306}, 'ŭ': {'u', 0x306},
+	// U+0307 kropka nad literą
+	'Ċ': {'C', 0x307}, 'ċ': {'c', 0x307}, 'Ė': {'E', 0x307}, 'ė': {'e', 0x307}, 'Ġ': {'G', 0x307}, 'ġ': {'g', 0x307},
+	'İ': {'I', 0x307}, 'Ż': {'Z', 0x307}, 'ż': {'z', 0x307},
+	// U+0308 diereza (umlaut)
+	'Ä': {'A', 0x308}, 'Ë': {'E', 0x308}, 'Ï': {'I', 0x308}, 'Ö': {'O', 0x308}, 'Ü': {'U', 0x308}, 'ä': {'a', 0x308},
+	'ë': {'e', 0x308}, 'ï': {'i', 0x308}, 'ö': {'o', 0x308}, 'ü': {'u', 0x308}, 'ÿ': {'y', 0x308}, 'Ÿ': {'Y', 0x308},
+	// U+030A kółko
+	'Å': {'A', 0x30a}, 'å': {'a', 0x30a}, 'Ů': {'U', 0x30a}, 'ů': {'u', 0x30a},
+	// U+030B podwójny akcent ostry
+	'Ő': {'O', 0x30b}, 'ő': {'o', 0x30b}, 'Ű': {'U', 0x30b}, 'ű': {'u', 0x30b},
+	// U+030C haczek (caron)
+	'Č': {'C', 0x30c}, 'č': {'c', 0x30c}, 'Ď': {'D', 0x30c}, 'ď': {'d', 0x30c}, 'Ě': {'E', 0x30c}, 'ě': {'e', 0x30c},
+	'Ľ': {'L', 0x30c}, 'ľ': {'l', 0x30c}, 'Ň': {'N', 0x30c}, 'ň': {'n', 0x30c}, 'Ř': {'R', 0x30c}, 'ř': {'r', 0x30c},
+	'Š': {'S', 0x30c}, 'š': {'s', 0x30c}, 'Ť': {'T', 0x30c}, 'ť': {'t', 0x30c}, 'Ž': {'Z', 0x30c}, 'ž': {'z', 0x30c},
+	// U+0327 cedylla
+	'Ç': {'C', 0x327}, 'ç': {'c', 0x327}, 'Ģ': {'G', 0x327}, 'ģ': {'g', 0x327}, 'Ķ': {'K', 0x327}, 'ķ': {'k', 0x327},
+	'Ļ': {'L', 0x327}, 'ļ': {'l', 0x327}, 'Ņ': {'N', 0x327}, 'ņ': {'n', 0x327}, 'Ŗ': {'R', 0x327}, 'ŗ': {'r', 0x327},
+	'Ş': {'S', 0x327}, 'ş': {'s', 0x327}, 'Ţ': {'T', 0x327}, 'ţ': {'t', 0x327},
+	// U+0328 ogonek
+	'Ą': {'A', 0x328}, 'ą': {'a', 0x328}, 'Ę': {'E', 0x328}, 'ę': {'e', 0x328}, 'Į': {'I', 0x328}, 'į': {'i', 0x328},
+	'Ų': {'U', 0x328}, 'ų': {'u', 0x328},
+}
+
+// compositions to odwrotność decompositions, używana przez NFC.
+var compositions = func() map[[2]rune]rune {
+	m := make(map[[2]rune]rune, len(decompositions))
+	for r, d := range decompositions {
+		m[d] = r
+	}
+	return m
+}()
+
+// combiningClasses to klasy łączenia (Canonical_Combining_Class) znaków łączących; pozostałe runy mają klasę 0.
+var combiningClasses = []struct {
+	lo, hi rune
+	ccc    uint8
+}{
+	{0x0300, 0x0314, 230}, {0x0315, 0x0315, 232}, {0x0316, 0x0319, 220}, {0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216}, {0x031C, 0x0320, 220}, {0x0321, 0x0322, 202}, {0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202}, {0x0329, 0x0333, 220}, {0x0334, 0x0338, 1}, {0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230}, {0x0345, 0x0345, 240}, {0x0346, 0x0346, 230}, {0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230}, {0x034D, 0x034E, 220}, {0x0350, 0x0352, 230}, {0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230}, {0x0358, 0x0358, 232}, {0x0359, 0x035A, 220}, {0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233}, {0x035D, 0x035E, 234}, {0x035F, 0x035F, 233}, {0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233}, {0x0363, 0x036F, 230}, {0x0483, 0x0487, 230}, {0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220}, {0x1ABB, 0x1ABC, 230}, {0x1ABD, 0x1ABD, 220}, {0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230}, {0x1AC3, 0x1AC4, 220}, {0x1AC5, 0x1AC9, 230}, {0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ACE, 230}, {0x1DC0, 0x1DC1, 230}, {0x1DC2, 0x1DC2, 220}, {0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220}, {0x1DCB, 0x1DCC, 230}, {0x1DCD, 0x1DCD, 234}, {0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220}, {0x1DD0, 0x1DD0, 202}, {0x1DD1, 0x1DF5, 230}, {0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228}, {0x1DF9, 0x1DF9, 220}, {0x1DFA, 0x1DFA, 218}, {0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233}, {0x1DFD, 0x1DFD, 220}, {0x1DFE, 0x1DFE, 230}, {0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230}, {0x20D2, 0x20D3, 1}, {0x20D4, 0x20D7, 230}, {0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230}, {0x20E1, 0x20E1, 230}, {0x20E5, 0x20E6, 1}, {0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220}, {0x20E9, 0x20E9, 230}, {0x20EA, 0x20EB, 1}, {0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230}, {0xFE20, 0xFE26, 230}, {0xFE27, 0xFE2D, 220}, {0xFE2E, 0xFE2F, 230},
+}
+
+func combiningClass(r rune) uint8 {
+	if r < 0x0300 {
+		return 0
+	}
+	for _, c := range combiningClasses {
+		if r < c.lo {
+			return 0
+		}
+		if r <= c.hi {
+			return c.ccc
+		}
+	}
+	return 0
+}
+
+// NFD zwraca s w postaci rozłożonej: litery z tabeli i sylaby Hangul są rozbite na znak bazowy i znaki łączące.
+func NFD(s string) string {
+	if isASCII(s) {
+		return s
+	}
+	return string(decompose(s))
+}
+
+// NFC zwraca s w postaci złożonej: najpierw rozkłada tekst, a potem składa pary, które mają jedną runę.
+func NFC(s string) string {
+	if isASCII(s) {
+		return s
+	}
+	return string(compose(decompose(s)))
+}
+
+// IsNFC mówi, czy s jest już w postaci NFC.
+func IsNFC(s string) bool {
+	return NFC(s) == s
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+decompose rozkłada runy, a następnie porządkuje znaki łączące według klasy (ang. canonical ordering),
+tak żeby np. "ą́" zapisane z ogonkiem i kreską w dowolnej kolejności dało ten sam ciąg run.
+*/
+func decompose(s string) []rune {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= hangulBase && r < hangulBase+hangulCount:
+			i := r - hangulBase
+			out = append(out, hangulLBase+i/(hangulVN*hangulTN), hangulVBase+i%(hangulVN*hangulTN)/hangulTN)
+			if t := i % hangulTN; t != 0 {
+				out = append(out, hangulTBase+t)
+			}
+		default:
+			if d, ok := decompositions[r]; ok {
+				out = append(out, d[0], d[1])
+			} else {
+				out = append(out, r)
+			}
+		}
+	}
+	// Sortujemy stabilnie każdy ciąg znaków o niezerowej klasie.
+	for i := 0; i < len(out); {
+		if combiningClass(out[i]) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(out) && combiningClass(out[j]) != 0 {
+			j++
+		}
+		slices.SortStableFunc(out[i:j], func(a, b rune) int {
+			return int(combiningClass(a)) - int(combiningClass(b))
+		})
+		i = j
+	}
+	return out
+}
+
+/*
+compose łączy znak bazowy (ang. starter) z kolejnymi znakami łączącymi, o ile nie są "zablokowane" -
+znak jest zablokowany, gdy między nim a bazą stoi znak o klasie 0 albo o klasie nie mniejszej niż jego.
+*/
+func compose(rs []rune) []rune {
+	out := rs[:0]
+	starter := -1
+	last := -1 // klasa ostatniego znaku za bazą; -1 gdy znak stoi tuż za bazą
+	for _, r := range rs {
+		ccc := int(combiningClass(r))
+		if starter >= 0 && (last == -1 || (last != 0 && last < ccc)) {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if ccc == 0 {
+			starter, last = len(out), -1
+		} else {
+			last = ccc
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func composePair(a, b rune) (rune, bool) {
+	switch {
+	case a >= hangulLBase && a < hangulLBase+19 && b >= hangulVBase && b < hangulVBase+hangulVN:
+		return hangulBase + ((a-hangulLBase)*hangulVN+(b-hangulVBase))*hangulTN, true
+	case a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTN == 0 && b > hangulTBase && b < hangulTBase+hangulTN:
+		return a + (b - hangulTBase), true
+	}
+	c, ok := compositions[[2]rune{a, b}]
+	return c, ok
+}
+
+// EqualNFC porównuje napisy po normalizacji, więc "ą" (U+0105) i "ą" są równe.
+func EqualNFC(a, b string) bool {
+	return a == b || NFC(a) == NFC(b)
+}
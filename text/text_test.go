@@ -0,0 +1,179 @@
+package text
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// uncovered to zakresy, których tabele pakietu nie obejmują. Linie plików testowych z takimi znakami są pomijane.
+type uncovered struct {
+	name   string
+	lo, hi rune
+}
+
+// skip zwraca nazwę pierwszego zakresu z ranges, do którego należy któraś z run.
+func skip(ranges []uncovered, rs []rune) (string, bool) {
+	for _, u := range ranges {
+		for _, r := range rs {
+			if r >= u.lo && r <= u.hi {
+				return u.name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// parseHex zamienia ciąg kodów szesnastkowych, np. "0061 0328", na runy.
+func parseHex(t *testing.T, field string) []rune {
+	t.Helper()
+	var rs []rune
+	for _, h := range strings.Fields(field) {
+		v, err := strconv.ParseUint(h, 16, 32)
+		if err != nil {
+			t.Fatalf("bad code point %q: %v", h, err)
+		}
+		rs = append(rs, rune(v))
+	}
+	return rs
+}
+
+// testLines zwraca niepuste linie pliku z testdata bez komentarzy, razem z ich numerami.
+func testLines(t *testing.T, name string) (lines []string, numbers []int) {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		if line = strings.TrimSpace(line); line != "" {
+			lines, numbers = append(lines, line), append(numbers, n)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines, numbers
+}
+
+func hex(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+/*
+normalizationSkipped to zakresy spoza tabeli rozkładów: obejmuje ona tylko Latin-1 Supplement,
+Latin Extended-A, znaki łączące U+0300-U+036F i Hangul.
+*/
+var normalizationSkipped = []uncovered{
+	{"Latin Extended-B and IPA", 0x0180, 0x02FF},
+	{"combining marks with singleton decompositions", 0x0340, 0x0344},
+	{"Greek, Cyrillic, Hebrew, Indic and other scripts", 0x0370, 0x10FF},
+	{"Latin Extended Additional and Greek Extended", 0x1E00, 0x1FFF},
+	{"letterlike symbols and other compatibility characters", 0x2000, 0xABFF},
+	{"Hangul Jamo Extended-B and CJK compatibility", 0xD7A4, 0x10FFFF},
+}
+
+// TestNormalization sprawdza kolumny NFC i NFD z NormalizationTest.txt; kolumny NFKC i NFKD są pomijane.
+func TestNormalization(t *testing.T) {
+	lines, numbers := testLines(t, "NormalizationTest.txt")
+	checked := 0
+	for i, line := range lines {
+		if strings.HasPrefix(line, "@") {
+			continue
+		}
+		cols := strings.Split(line, ";")
+		if len(cols) < 5 {
+			t.Fatalf("line %d: want 5 columns, got %q", numbers[i], line)
+		}
+		var c [3][]rune
+		for j := range c {
+			c[j] = parseHex(t, cols[j])
+		}
+		if name, ok := skip(normalizationSkipped, append(append(c[0], c[1]...), c[2]...)); ok {
+			t.Logf("line %d: skipped (%s)", numbers[i], name)
+			continue
+		}
+		src, nfc, nfd := string(c[0]), string(c[1]), string(c[2])
+		for _, tc := range []struct {
+			name      string
+			got, want string
+		}{
+			{"NFC(c1)", NFC(src), nfc},
+			{"NFC(c2)", NFC(nfc), nfc},
+			{"NFC(c3)", NFC(nfd), nfc},
+			{"NFD(c1)", NFD(src), nfd},
+			{"NFD(c2)", NFD(nfc), nfd},
+			{"NFD(c3)", NFD(nfd), nfd},
+		} {
+			if tc.got != tc.want {
+				t.Errorf("line %d: %s = %s, want %s", numbers[i], tc.name, hex(tc.got), hex(tc.want))
+			}
+		}
+		if !IsNFC(nfc) {
+			t.Errorf("line %d: IsNFC(%s) = false", numbers[i], hex(nfc))
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatal("no lines checked")
+	}
+}
+
+// graphemeSkipped to pisma, których znaków tabela właściwości Grapheme_Cluster_Break nie obejmuje.
+var graphemeSkipped = []uncovered{
+	{"Thai: SARA AM is a SpacingMark", 0x0E00, 0x0E7F},
+	{"Malayalam: DOT REPH is Prepend", 0x0D00, 0x0D7F},
+}
+
+// TestGraphemes sprawdza podział na grafemy z GraphemeBreakTest.txt.
+func TestGraphemes(t *testing.T) {
+	lines, numbers := testLines(t, "GraphemeBreakTest.txt")
+	checked := 0
+	for i, line := range lines {
+		var want []string
+		var cur, all []rune
+		for _, f := range strings.Fields(line) {
+			switch f {
+			case "÷":
+				if len(cur) > 0 {
+					want = append(want, string(cur))
+					cur = nil
+				}
+			case "×":
+			default:
+				r := parseHex(t, f)
+				cur, all = append(cur, r...), append(all, r...)
+			}
+		}
+		if name, ok := skip(graphemeSkipped, all); ok {
+			t.Logf("line %d: skipped (%s)", numbers[i], name)
+			continue
+		}
+		s := string(all)
+		got := Graphemes(s)
+		if !slices.Equal(got, want) {
+			t.Errorf("line %d: Graphemes(%s) = %q, want %q", numbers[i], hex(s), got, want)
+		}
+		if n := GraphemeCount(s); n != len(want) {
+			t.Errorf("line %d: GraphemeCount(%s) = %d, want %d", numbers[i], hex(s), n, len(want))
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatal("no lines checked")
+	}
+}
@@ -0,0 +1,71 @@
+package text
+
+import "unicode/utf8"
+
+// TruncateBytes skraca s do co najwyżej n bajtów, np. przed zapisem do kolumny o stałym rozmiarze w bazie danych.
+func TruncateBytes(s string, n int) string {
+	return truncate(s, n, func(g string) int { return len(g) })
+}
+
+// TruncateRunes skraca s do co najwyżej n run.
+func TruncateRunes(s string, n int) string {
+	return truncate(s, n, utf8.RuneCountInString)
+}
+
+// TruncateGraphemes skraca s do co najwyżej n grafemów.
+func TruncateGraphemes(s string, n int) string {
+	return truncate(s, n, func(string) int { return 1 })
+}
+
+/*
+TruncateWidth skraca s tak, żeby razem z tail (np. "…") zajmowało co najwyżej width kolumn terminala.
+Jeśli s się mieści, zwracane jest bez zmian i bez tail. Jeśli nie mieści się nawet sam tail,
+skracany jest tail - wynik nigdy nie jest szerszy niż width (dla width <= 0 to "").
+*/
+func TruncateWidth(s string, width int, tail string) string {
+	if Width(s) <= width {
+		return s
+	}
+	if Width(tail) >= width {
+		return truncate(tail, width, graphemeWidth)
+	}
+	return truncate(s, width-Width(tail), graphemeWidth) + tail
+}
+
+/*
+truncate zwraca najdłuższy prefiks s złożony z całych grafemów, których łączny koszt nie przekracza limitu.
+Dzięki temu żadna z funkcji Truncate* nie rozdziela litery i jej znaku łączącego ani sekwencji emoji -
+różnią się tylko jednostką limitu. Zwykłe s[:n] może przeciąć runę w połowie i zostawić niepoprawny UTF-8.
+*/
+func truncate(s string, limit int, cost func(grapheme string) int) string {
+	used, end := 0, 0
+	for end < len(s) {
+		n := FirstGrapheme(s[end:])
+		c := cost(s[end : end+n])
+		if used+c > limit {
+			break
+		}
+		used += c
+		end += n
+	}
+	return s[:end]
+}
+
+// Reverse odwraca kolejność grafemów, więc "Zażółć" daje "ćłóżaZ" także wtedy, gdy litery są w postaci NFD.
+func Reverse(s string) string {
+	gs := Graphemes(s)
+	b := make([]byte, 0, len(s))
+	for i := len(gs) - 1; i >= 0; i-- {
+		b = append(b, gs[i]...)
+	}
+	return string(b)
+}
+
+// ReverseRunes odwraca kolejność run - dla porównania: znaki łączące trafiają wtedy do złej litery.
+func ReverseRunes(s string) string {
+	rs := []rune(s)
+	for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+		rs[i], rs[j] = rs[j], rs[i]
+	}
+	return string(rs)
+}
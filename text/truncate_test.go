@@ -0,0 +1,93 @@
+package text
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	const nfd = "Zaz\u0307o\u0301łc\u0301" // "Zażółć" w NFD
+	tests := []struct {
+		name string
+		fn   func(string, int) string
+		s    string
+		n    int
+		want string
+	}{
+		{"bytes ascii", TruncateBytes, "hello", 3, "hel"},
+		{"bytes does not cut a rune", TruncateBytes, "żółw", 3, "ż"},
+		{"bytes does not cut a combining mark", TruncateBytes, nfd, 4, "Za"},
+		{"bytes zero", TruncateBytes, "abc", 0, ""},
+		{"runes", TruncateRunes, "żółw", 2, "żó"},
+		{"runes keep mark with letter", TruncateRunes, nfd, 3, "Za"},
+		{"graphemes", TruncateGraphemes, nfd, 3, "Zaz\u0307"},
+		{"graphemes emoji", TruncateGraphemes, "a👨‍👩‍👧b", 2, "a👨‍👩‍👧"},
+		{"graphemes flags", TruncateGraphemes, "🇵🇱🇩🇪", 1, "🇵🇱"},
+		{"longer than s", TruncateGraphemes, "ab", 10, "ab"},
+		{"negative", TruncateRunes, "ab", -1, ""},
+	}
+	for _, tt := range tests {
+		got := tt.fn(tt.s, tt.n)
+		if got != tt.want {
+			t.Errorf("%s: (%q, %d) = %q, want %q", tt.name, tt.s, tt.n, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("%s: invalid UTF-8 %q", tt.name, got)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		tail  string
+		want  string
+	}{
+		{"Wartość", 10, "…", "Wartość"},
+		{"Wartość", 7, "…", "Wartość"},
+		{"Wartość", 5, "…", "Wart…"},
+		{"日本語テキスト", 7, "…", "日本語…"},
+		{"日本語テキスト", 6, "…", "日本…"}, // 3. znak CJK się nie mieści, zostaje wolna kolumna
+		{"abcdef", 4, "...", "a..."},
+		// tail szerszy niż width jest sam skracany, żeby wynik się zmieścił
+		{"abcdef", 3, "...", "..."},
+		{"abcdef", 2, "...", ".."},
+		{"abcdef", 1, "→→", "→"},
+		{"abcdef", 0, "…", ""},
+		{"abcdef", -1, "…", ""},
+		{"日本語", 1, "日", ""},
+	}
+	for _, tt := range tests {
+		got := TruncateWidth(tt.s, tt.width, tt.tail)
+		if got != tt.want {
+			t.Errorf("TruncateWidth(%q, %d, %q) = %q, want %q", tt.s, tt.width, tt.tail, got, tt.want)
+		}
+		if w := Width(got); w > max(tt.width, 0) {
+			t.Errorf("TruncateWidth(%q, %d, %q) has width %d", tt.s, tt.width, tt.tail, w)
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"abc", "cba"},
+		{"Zażółć", "ćłóżaZ"},
+		{"Zaz\u0307o\u0301", "o\u0301z\u0307aZ"}, // NFD: znak łączący zostaje przy swojej literze
+		{"a🇵🇱b", "b🇵🇱a"},
+		{"x👨‍👩‍👧y", "y👨‍👩‍👧x"},
+		{"\r\nx", "x\r\n"},
+	}
+	for _, tt := range tests {
+		if got := Reverse(tt.s); got != tt.want {
+			t.Errorf("Reverse(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+	// ReverseRunes dla porównania przenosi znak łączący na poprzednią literę.
+	if got, want := ReverseRunes("Zaz\u0307"), "\u0307zaZ"; got != want {
+		t.Errorf("ReverseRunes = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,234 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+RuneInfo opisuje jedną runę napisu i jej zapis w UTF-8.
+Dla niepoprawnego bajtu Valid jest false, Rune to utf8.RuneError, a Problem wyjaśnia, co jest nie tak.
+*/
+type RuneInfo struct {
+	Offset   int    // pozycja pierwszego bajtu w napisie
+	Bytes    []byte // bajty UTF-8 tej runy
+	Rune     rune
+	Valid    bool
+	Grapheme int // numer grafemu (od 0), do którego należy runa
+	Category string
+	Name     string
+	Problem  string
+}
+
+// CodePoint zwraca zapis U+XXXX.
+func (ri RuneInfo) CodePoint() string {
+	if !ri.Valid {
+		return "-"
+	}
+	return fmt.Sprintf("U+%04X", ri.Rune)
+}
+
+// Hex zwraca bajty szesnastkowo, np. "c4 85".
+func (ri RuneInfo) Hex() string {
+	return fmt.Sprintf("% x", ri.Bytes)
+}
+
+/*
+Binary zwraca bajty binarnie z nagłówkiem UTF-8 oddzielonym od bitów znaku, np. "110|00100 10|000101".
+Nagłówek pierwszego bajtu mówi, ile bajtów ma sekwencja (0, 110, 1110, 11110), a każdy bajt kontynuacji zaczyna się od 10.
+*/
+func (ri RuneInfo) Binary() string {
+	parts := make([]string, len(ri.Bytes))
+	for i, b := range ri.Bytes {
+		bits := fmt.Sprintf("%08b", b)
+		header := 0
+		switch {
+		case !ri.Valid:
+		case i > 0:
+			header = 2
+		case len(ri.Bytes) == 1:
+			header = 1
+		default:
+			header = len(ri.Bytes) + 1
+		}
+		if header > 0 {
+			bits = bits[:header] + "|" + bits[header:]
+		}
+		parts[i] = bits
+	}
+	return strings.Join(parts, " ")
+}
+
+// Explore dzieli s na runy tak jak pętla for range, ale zachowuje też niepoprawne bajty i ich opis.
+func Explore(s string) []RuneInfo {
+	var out []RuneInfo
+	grapheme, graphemeEnd := -1, 0
+	for i := 0; i < len(s); {
+		if i >= graphemeEnd {
+			grapheme++
+			graphemeEnd = i + FirstGrapheme(s[i:])
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		ri := RuneInfo{Offset: i, Bytes: []byte(s[i : i+size]), Rune: r, Valid: true, Grapheme: grapheme}
+		if r == utf8.RuneError && size == 1 {
+			ri.Valid = false
+			ri.Problem = invalidReason(s[i:])
+		} else {
+			ri.Category = Category(r)
+			ri.Name = Name(r)
+		}
+		out = append(out, ri)
+		i += size
+	}
+	return out
+}
+
+// invalidReason wyjaśnia, dlaczego sekwencja zaczynająca się od s[0] nie jest poprawnym UTF-8.
+func invalidReason(s string) string {
+	b := s[0]
+	switch {
+	case b&0xC0 == 0x80:
+		return "unexpected continuation byte"
+	case b == 0xC0 || b == 0xC1 || b >= 0xF5:
+		return "byte never used in UTF-8"
+	}
+	need := 2
+	if b >= 0xF0 {
+		need = 4
+	} else if b >= 0xE0 {
+		need = 3
+	}
+	for k := 1; k < need; k++ {
+		if k >= len(s) || s[k]&0xC0 != 0x80 {
+			return fmt.Sprintf("truncated %d-byte sequence", need)
+		}
+	}
+	return "overlong encoding, surrogate or value above U+10FFFF"
+}
+
+/*
+categories to dwuliterowe kategorie ogólne Unicode, np. Lu (wielka litera) lub Mn (znak łączący).
+Pomijamy LC (dowolna litera z wielkością), które w unicode.Categories pokrywa się z Lu, Ll i Lt.
+*/
+var categories = func() []string {
+	var out []string
+	for name := range unicode.Categories {
+		if len(name) == 2 && name != "LC" {
+			out = append(out, name)
+		}
+	}
+	slices.Sort(out)
+	return out
+}()
+
+// Category zwraca dwuliterową kategorię ogólną runy; "Cn" oznacza znak nieprzypisany.
+func Category(r rune) string {
+	for _, name := range categories {
+		if unicode.Is(unicode.Categories[name], r) {
+			return name
+		}
+	}
+	return "Cn"
+}
+
+// WriteTable wypisuje tabelę run napisu s wraz z ich zapisem w UTF-8.
+func WriteTable(w io.Writer, s string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "OFFSET\tG\tCHAR\tCODE POINT\tHEX\tBINARY\tCAT\tNAME")
+	for _, ri := range Explore(s) {
+		char := string(ri.Rune)
+		name := ri.Name
+		if !ri.Valid {
+			char, name = "�", "!! invalid: "+ri.Problem
+		} else if !unicode.IsGraphic(ri.Rune) || unicode.Is(unicode.Mn, ri.Rune) {
+			// Znaki niewidoczne i łączące wypisujemy jako kod, żeby nie rozjechały tabeli.
+			char = fmt.Sprintf("%+q", ri.Rune)
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			ri.Offset, ri.Grapheme, char, ri.CodePoint(), ri.Hex(), ri.Binary(), ri.Category, name)
+	}
+	return tw.Flush()
+}
+
+// Conversion to koszt jednej konwersji zmierzony przez MeasureConversions.
+type Conversion struct {
+	Name        string
+	AllocsPerOp float64
+	BytesPerOp  float64
+}
+
+// Wyniki konwersji trafiają do zmiennych pakietu, żeby kompilator nie mógł ich pominąć ani trzymać na stosie.
+var (
+	sinkBytes  []byte
+	sinkRunes  []rune
+	sinkString string
+	sinkInt    int
+	sinkBool   bool
+)
+
+/*
+MeasureConversions mierzy alokacje konwersji między string, []byte i []rune dla napisu s,
+porównując runtime.MemStats przed i po runs powtórzeniach.
+
+  - []byte(s) i string(b) kopiują bajty, bo string jest niezmienny, a wycinek można modyfikować,
+  - []rune(s) dekoduje UTF-8 do 4 bajtów na runę, string(r) koduje z powrotem,
+  - for range s, utf8.RuneCountInString i porównanie string(b) == s nie alokują nic.
+*/
+func MeasureConversions(s string, runs int) []Conversion {
+	b, r := []byte(s), []rune(s)
+	m := map[string]int{s: 1}
+	cases := []struct {
+		name string
+		f    func()
+	}{
+		{"[]byte(s)", func() { sinkBytes = []byte(s) }},
+		{"[]rune(s)", func() { sinkRunes = []rune(s) }},
+		{"string(bytes)", func() { sinkString = string(b) }},
+		{"string(runes)", func() { sinkString = string(r) }},
+		{"for range s", func() {
+			n := 0
+			for range s {
+				n++
+			}
+			sinkInt = n
+		}},
+		{"utf8.RuneCountInString(s)", func() { sinkInt = utf8.RuneCountInString(s) }},
+		{"string(bytes) == s", func() { sinkBool = string(b) == s }},
+		{"m[string(bytes)]", func() { sinkInt = m[string(b)] }},
+	}
+	out := make([]Conversion, len(cases))
+	for i, c := range cases {
+		allocs, bytes := measure(c.f, runs)
+		out[i] = Conversion{c.name, allocs, bytes}
+	}
+	return out
+}
+
+func measure(f func(), runs int) (allocs, bytes float64) {
+	f() // rozgrzewka, żeby jednorazowe alokacje nie zawyżyły wyniku
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < runs; i++ {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+	n := float64(runs)
+	return float64(after.Mallocs-before.Mallocs) / n, float64(after.TotalAlloc-before.TotalAlloc) / n
+}
+
+// WriteConversions wypisuje tabelę kosztów konwersji dla napisu s.
+func WriteConversions(w io.Writer, s string, runs int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "CONVERSION\tALLOCS/OP\tBYTES/OP\t\n")
+	for _, c := range MeasureConversions(s, runs) {
+		fmt.Fprintf(tw, "%s\t%.1f\t%.0f\t\n", c.Name, c.AllocsPerOp, c.BytesPerOp)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,52 @@
+package text
+
+import "testing"
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"żółw", 4},
+		{"z\u0307o\u0301", 2}, // NFD: znaki łączące nie zajmują kolumn
+		{"日本語", 6},
+		{"한국", 4},
+		{"\u1100\u1161", 2}, // sylaba Hangul z jamo - samogłoska dokleja się do spółgłoski
+		{"a\tb", 2},
+		{"\u200b", 0},
+		{"👍", 2},
+		{"👍🏽", 2},
+		{"👨‍👩‍👧", 2},
+		{"🇵🇱", 2},
+		{"❤️", 2},
+		{"❤", 1},
+	}
+	for _, tt := range tests {
+		if got := Width(tt.s); got != tt.want {
+			t.Errorf("Width(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		s           string
+		width       int
+		left, right string
+	}{
+		{"ab", 4, "  ab", "ab  "},
+		{"żó", 3, " żó", "żó "},
+		{"日本", 5, " 日本", "日本 "},
+		{"abcdef", 3, "abcdef", "abcdef"},
+	}
+	for _, tt := range tests {
+		if got := PadLeft(tt.s, tt.width); got != tt.left {
+			t.Errorf("PadLeft(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.left)
+		}
+		if got := PadRight(tt.s, tt.width); got != tt.right {
+			t.Errorf("PadRight(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.right)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package textproc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+)
+
+// gzipMagic to pierwsze dwa bajty każdego pliku gzip.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+/*
+Decompress zwraca czytnik, który rozpakowuje gzip, jeśli dane się od niego zaczynają, a w przeciwnym razie
+przekazuje je bez zmian. Podglądamy pierwsze bajty przez bufio.Reader.Peek, więc nic nie jest tracone
+i wejście nie musi obsługiwać Seek - działa także dla potoku (cat plik.gz | textproc).
+*/
+func Decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(magic) == len(gzipMagic) && magic[0] == gzipMagic[0] && magic[1] == gzipMagic[1] {
+		return gzip.NewReader(br)
+	}
+	return br, nil
+}
+
+// MaxRecord to domyślny limit długości jednego rekordu; bufio.Scanner domyślnie pozwala tylko na 64 KiB.
+const MaxRecord = 16 << 20
+
+/*
+NewScanner tworzy skaner z podaną funkcją podziału. Bufor zaczyna od 64 KiB i rośnie w razie potrzeby do maxRecord
+(0 oznacza MaxRecord), dzięki czemu wielogigabajtowe wejście czytamy kawałkami, a długie rekordy nie kończą się
+błędem bufio.ErrTooLong.
+*/
+func NewScanner(r io.Reader, split bufio.SplitFunc, maxRecord int) *bufio.Scanner {
+	if maxRecord <= 0 {
+		maxRecord = MaxRecord
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64<<10), maxRecord)
+	sc.Split(split)
+	return sc
+}
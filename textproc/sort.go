@@ -0,0 +1,244 @@
+package textproc
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"slices"
+)
+
+/*
+Sort sortuje rekordy leksykograficznie (po bajtach) z ograniczoną pamięcią.
+
+Rekordy są zbierane w pamięci, dopóki ich rozmiar nie przekroczy MaxMemory. Wtedy posortowana porcja
+(ang. run) jest zapisywana do pliku tymczasowego. Na końcu wszystkie porcje są scalane (ang. k-way merge)
+za pomocą kopca: w pamięci jest wtedy tylko jeden rekord z każdej porcji. To sortowanie zewnętrzne,
+którym sort z coreutils radzi sobie z plikami większymi niż pamięć RAM.
+
+Rekordy są zapisywane z prefiksem długości, więc mogą zawierać znaki nowej linii (np. rekordy CSV).
+*/
+type Sort struct {
+	MaxMemory int    // limit bajtów trzymanych w pamięci; 0 oznacza 64 MiB
+	Reverse   bool   // sortowanie malejące
+	TempDir   string // katalog na pliki tymczasowe; "" oznacza os.TempDir()
+	MaxFanIn  int    // ile porcji scalać naraz (tyle plików jest otwartych); 0 oznacza DefaultFanIn
+
+	buf     []string
+	size    int
+	runs    []string
+	spilled int
+}
+
+// recordOverhead przybliża narzut pamięci na rekord (nagłówek stringa i element wycinka).
+const recordOverhead = 32
+
+func (s *Sort) Push(rec string, _ Emit) error {
+	s.buf = append(s.buf, rec)
+	s.size += len(rec) + recordOverhead
+	limit := s.MaxMemory
+	if limit <= 0 {
+		limit = 64 << 20
+	}
+	if s.size >= limit {
+		return s.spill()
+	}
+	return nil
+}
+
+// Runs zwraca liczbę porcji zapisanych na dysk; 0 oznacza, że całe wejście zmieściło się w pamięci.
+func (s *Sort) Runs() int {
+	return s.spilled
+}
+
+func (s *Sort) less(a, b string) int {
+	if s.Reverse {
+		a, b = b, a
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// spill sortuje bufor i zapisuje go jako kolejną porcję na dysku.
+func (s *Sort) spill() error {
+	slices.SortFunc(s.buf, s.less)
+	rw, err := s.newRun()
+	if err != nil {
+		return err
+	}
+	s.spilled++
+	for _, rec := range s.buf {
+		if err = rw.write(rec); err != nil {
+			break
+		}
+	}
+	s.buf, s.size = s.buf[:0], 0
+	return errors.Join(err, rw.close())
+}
+
+// newRun tworzy pusty plik porcji. Plik od razu trafia do s.runs, więc cleanup usunie go także po błędzie.
+func (s *Sort) newRun() (*runWriter, error) {
+	f, err := os.CreateTemp(s.TempDir, "textproc-sort-*")
+	if err != nil {
+		return nil, err
+	}
+	s.runs = append(s.runs, f.Name())
+	return &runWriter{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+func (s *Sort) Close(emit Emit) error {
+	defer s.cleanup()
+	if len(s.runs) == 0 {
+		slices.SortFunc(s.buf, s.less)
+		for _, rec := range s.buf {
+			if err := emit(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(s.buf) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	return s.merge(emit)
+}
+
+// DefaultFanIn to domyślna liczba porcji scalanych naraz, a więc i jednocześnie otwartych plików.
+const DefaultFanIn = 64
+
+/*
+merge scala porcje w kolejnych przebiegach. Naraz otwieramy najwyżej MaxFanIn plików - przy większej liczbie porcji
+najstarsze są scalane do nowej porcji na końcu kolejki, aż zostanie ich tyle, że ostatni przebieg wyśle wynik do emit.
+Bez tego limitu duże wejście z małym MaxMemory kończyło się błędem "too many open files".
+*/
+func (s *Sort) merge(emit Emit) error {
+	fanIn := s.MaxFanIn
+	if fanIn < 2 {
+		fanIn = DefaultFanIn
+	}
+	for len(s.runs) > fanIn {
+		batch := slices.Clone(s.runs[:fanIn])
+		rw, err := s.newRun()
+		if err != nil {
+			return err
+		}
+		if err := errors.Join(mergeRuns(batch, s.less, rw.write), rw.close()); err != nil {
+			return err
+		}
+		for _, name := range batch {
+			os.Remove(name)
+		}
+		s.runs = s.runs[fanIn:]
+	}
+	return mergeRuns(s.runs, s.less, emit)
+}
+
+// mergeRuns scala posortowane porcje names w jeden posortowany strumień (ang. k-way merge) za pomocą kopca.
+func mergeRuns(names []string, less func(a, b string) int, emit Emit) error {
+	h := &mergeHeap{less: less}
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r := &runReader{r: bufio.NewReader(f)}
+		if ok, err := r.next(); err != nil {
+			return err
+		} else if ok {
+			h.items = append(h.items, r)
+		}
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		top := h.items[0]
+		if err := emit(top.rec); err != nil {
+			return err
+		}
+		ok, err := top.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return nil
+}
+
+func (s *Sort) cleanup() {
+	for _, name := range s.runs {
+		os.Remove(name)
+	}
+	s.runs, s.buf, s.size = nil, nil, 0
+}
+
+// runWriter zapisuje rekordy porcji z prefiksem długości (uvarint).
+type runWriter struct {
+	f      *os.File
+	w      *bufio.Writer
+	lenBuf [binary.MaxVarintLen64]byte
+}
+
+func (rw *runWriter) write(rec string) error {
+	n := binary.PutUvarint(rw.lenBuf[:], uint64(len(rec)))
+	if _, err := rw.w.Write(rw.lenBuf[:n]); err != nil {
+		return err
+	}
+	_, err := rw.w.WriteString(rec)
+	return err
+}
+
+func (rw *runWriter) close() error {
+	return errors.Join(rw.w.Flush(), rw.f.Close())
+}
+
+// runReader czyta kolejne rekordy jednej porcji.
+type runReader struct {
+	r   *bufio.Reader
+	rec string
+}
+
+func (rr *runReader) next() (bool, error) {
+	n, err := binary.ReadUvarint(rr.r)
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rr.r, b); err != nil {
+		return false, err
+	}
+	rr.rec = string(b)
+	return true, nil
+}
+
+// mergeHeap to kopiec porcji uporządkowany po ich bieżącym rekordzie.
+type mergeHeap struct {
+	items []*runReader
+	less  func(a, b string) int
+}
+
+func (h *mergeHeap) Len() int           { return len(h.items) }
+func (h *mergeHeap) Less(i, j int) bool { return h.less(h.items[i].rec, h.items[j].rec) < 0 }
+func (h *mergeHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *mergeHeap) Push(x any)         { h.items = append(h.items, x.(*runReader)) }
+
+func (h *mergeHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,94 @@
+/*
+Pakiet textproc to zestaw małych etapów przetwarzania strumieni w stylu wc, grep, uniq i sort.
+
+Wejście dzielone jest na rekordy przez bufio.Scanner z wybraną funkcją podziału (bufio.SplitFunc):
+linie, słowa, rekordy CSV (z cudzysłowami obejmującymi wiele linii) lub rekordy o stałej szerokości.
+Rekordy przechodzą kolejno przez etapy (Stage), a każdy etap może je przepuścić, odrzucić, zmienić
+albo wstrzymać do końca strumienia - tak jak sort. Dzięki temu pamięć zależy od etapów, a nie od rozmiaru wejścia.
+*/
+package textproc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ScanLines dzieli na linie bez końcowego \r\n lub \n (to samo co bufio.ScanLines).
+var ScanLines bufio.SplitFunc = bufio.ScanLines
+
+// ScanWords dzieli na słowa oddzielone białymi znakami Unicode (to samo co bufio.ScanWords).
+var ScanWords bufio.SplitFunc = bufio.ScanWords
+
+/*
+ScanCSVRecords dzieli na rekordy CSV. W odróżnieniu od ScanLines nie tnie na znaku nowej linii
+wewnątrz pola w cudzysłowie, np. rekord `1,"dwie` + "\n" + `linie",3` zostaje w całości.
+Rekord nie jest dzielony na pola - do tego służy encoding/csv.
+*/
+func ScanCSVRecords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	inQuote := false
+	for i, b := range data {
+		switch {
+		case b == '"':
+			// Podwojony cudzysłów "" wewnątrz pola zmienia stan dwa razy, więc nie wymaga osobnej obsługi.
+			inQuote = !inQuote
+		case b == '\n' && !inQuote:
+			return i + 1, bytes.TrimSuffix(data[:i], []byte{'\r'}), nil
+		}
+	}
+	if atEOF && len(data) > 0 {
+		if inQuote {
+			return 0, nil, fmt.Errorf("textproc: unterminated quoted field in CSV record %q", truncate(data, 40))
+		}
+		return len(data), bytes.TrimSuffix(data, []byte{'\r'}), nil
+	}
+	return 0, nil, nil // potrzeba więcej danych
+}
+
+// ScanFixed zwraca funkcję dzielącą wejście na rekordy po width bajtów; ostatni rekord może być krótszy.
+func ScanFixed(width int) bufio.SplitFunc {
+	if width <= 0 {
+		panic("textproc: ScanFixed width must be positive")
+	}
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		switch {
+		case len(data) >= width:
+			return width, data[:width], nil
+		case atEOF && len(data) > 0:
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+/*
+Splitter zwraca funkcję podziału po nazwie: "lines", "words", "csv" albo "fixed:N".
+Ułatwia wybór funkcji w programach sterowanych flagami.
+*/
+func Splitter(name string) (bufio.SplitFunc, error) {
+	switch name {
+	case "lines", "":
+		return ScanLines, nil
+	case "words":
+		return ScanWords, nil
+	case "csv":
+		return ScanCSVRecords, nil
+	}
+	if w, ok := strings.CutPrefix(name, "fixed:"); ok {
+		n, err := strconv.Atoi(w)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("textproc: invalid fixed width %q", w)
+		}
+		return ScanFixed(n), nil
+	}
+	return nil, fmt.Errorf("textproc: unknown split %q (want lines, words, csv or fixed:N)", name)
+}
+
+func truncate(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
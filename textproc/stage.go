@@ -0,0 +1,208 @@
+package textproc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Emit przekazuje rekord do następnego etapu.
+type Emit func(record string) error
+
+/*
+Stage to jeden etap przetwarzania. Push dostaje kolejne rekordy, a Close jest wywoływane raz po końcu wejścia -
+etapy, które muszą zobaczyć całe wejście (sort, licznik), wysyłają wtedy swoje wyniki.
+*/
+type Stage interface {
+	Push(record string, emit Emit) error
+	Close(emit Emit) error
+}
+
+// ErrStop kończy przetwarzanie bez błędu, np. gdy Head ma już wszystkie rekordy.
+var ErrStop = errors.New("textproc: stop")
+
+/*
+Run czyta rekordy z r, przepuszcza je przez etapy i zapisuje każdy rekord, który dotrze do końca, do w
+(jeden na linię). Wejście w formacie gzip jest rozpoznawane automatycznie.
+Close jest wywoływane dla każdego etapu także po błędzie, żeby etapy mogły zwolnić zasoby (np. pliki tymczasowe Sort).
+*/
+func Run(r io.Reader, w io.Writer, split bufio.SplitFunc, stages ...Stage) (err error) {
+	closed := 0 // liczba etapów, dla których wywołano już Close
+	defer func() {
+		// Po błędzie nie przekazujemy już rekordów dalej: emit zwraca ErrStop, a etap tylko sprząta.
+		stop := func(string) error { return ErrStop }
+		for _, stage := range stages[closed:] {
+			if cerr := stage.Close(stop); cerr != nil && !errors.Is(cerr, ErrStop) {
+				err = errors.Join(err, cerr)
+			}
+		}
+	}()
+
+	in, err := Decompress(r)
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(w)
+	emits := make([]Emit, len(stages)+1)
+	emits[len(stages)] = func(rec string) error {
+		out.WriteString(rec)
+		return out.WriteByte('\n')
+	}
+	for i := len(stages) - 1; i >= 0; i-- {
+		stage, next := stages[i], emits[i+1]
+		emits[i] = func(rec string) error { return stage.Push(rec, next) }
+	}
+
+	sc := NewScanner(in, split, 0)
+	for sc.Scan() {
+		if err := emits[0](sc.Text()); err != nil {
+			if errors.Is(err, ErrStop) {
+				break
+			}
+			return err
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	// Etap i może przy zamykaniu wysłać rekordy do etapu i+1, więc zamykamy od początku.
+	for i, stage := range stages {
+		closed = i + 1
+		if err := stage.Close(emits[i+1]); err != nil && !errors.Is(err, ErrStop) {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// grep przepuszcza rekordy pasujące do wyrażenia regularnego (albo niepasujące, gdy invert).
+type grep struct {
+	re     *regexp.Regexp
+	invert bool
+}
+
+// Grep przepuszcza rekordy pasujące do re; invert odwraca warunek jak grep -v.
+func Grep(re *regexp.Regexp, invert bool) Stage {
+	return grep{re, invert}
+}
+
+func (g grep) Push(rec string, emit Emit) error {
+	if g.re.MatchString(rec) != g.invert {
+		return emit(rec)
+	}
+	return nil
+}
+
+func (grep) Close(Emit) error { return nil }
+
+// Uniq usuwa powtórzenia występujące bezpośrednio po sobie, jak uniq; z Count poprzedza rekord liczbą wystąpień.
+type Uniq struct {
+	Count bool
+	prev  string
+	n     int
+}
+
+func (u *Uniq) Push(rec string, emit Emit) error {
+	if u.n > 0 && rec == u.prev {
+		u.n++
+		return nil
+	}
+	if err := u.flush(emit); err != nil {
+		return err
+	}
+	u.prev, u.n = rec, 1
+	return nil
+}
+
+func (u *Uniq) Close(emit Emit) error {
+	return u.flush(emit)
+}
+
+func (u *Uniq) flush(emit Emit) error {
+	if u.n == 0 {
+		return nil
+	}
+	if u.Count {
+		return emit(fmt.Sprintf("%7d %s", u.n, u.prev))
+	}
+	return emit(u.prev)
+}
+
+/*
+Dedup usuwa wszystkie powtórzenia, nie tylko sąsiednie, zachowując kolejność pierwszych wystąpień.
+Pamięta każdy unikalny rekord, więc przy dużych wejściach lepiej użyć Sort z Uniq, które mieszczą się w limicie pamięci.
+*/
+type Dedup struct {
+	seen map[string]struct{}
+}
+
+func (d *Dedup) Push(rec string, emit Emit) error {
+	if d.seen == nil {
+		d.seen = map[string]struct{}{}
+	}
+	if _, ok := d.seen[rec]; ok {
+		return nil
+	}
+	d.seen[rec] = struct{}{}
+	return emit(rec)
+}
+
+func (d *Dedup) Close(Emit) error { return nil }
+
+// head przepuszcza pierwsze n rekordów, a potem zatrzymuje czytanie wejścia.
+type head struct {
+	n, seen int
+}
+
+// Head przepuszcza tylko pierwsze n rekordów.
+func Head(n int) Stage {
+	return &head{n: n}
+}
+
+func (h *head) Push(rec string, emit Emit) error {
+	if h.seen >= h.n {
+		return ErrStop
+	}
+	h.seen++
+	if err := emit(rec); err != nil {
+		return err
+	}
+	if h.seen == h.n {
+		return ErrStop
+	}
+	return nil
+}
+
+func (h *head) Close(Emit) error { return nil }
+
+// Map zmienia każdy rekord funkcją f, np. Map(strings.ToLower).
+type Map func(string) string
+
+func (m Map) Push(rec string, emit Emit) error { return emit(m(rec)) }
+
+func (Map) Close(Emit) error { return nil }
+
+/*
+Count liczy rekordy, słowa, znaki i bajty jak wc, i nie przepuszcza rekordów dalej.
+Bajty nie obejmują separatorów usuniętych przez funkcję podziału (np. znaków nowej linii).
+Przy zamknięciu emituje jeden wiersz podsumowania.
+*/
+type Count struct {
+	Records, Words, Runes, Bytes int
+}
+
+func (c *Count) Push(rec string, _ Emit) error {
+	c.Records++
+	c.Words += len(strings.Fields(rec))
+	c.Runes += utf8.RuneCountInString(rec)
+	c.Bytes += len(rec)
+	return nil
+}
+
+func (c *Count) Close(emit Emit) error {
+	return emit(fmt.Sprintf("records=%d words=%d runes=%d bytes=%d", c.Records, c.Words, c.Runes, c.Bytes))
+}
@@ -0,0 +1,126 @@
+package textproc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func shuffledLines(n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%06d", i)
+	}
+	rand.New(rand.NewPCG(1, 2)).Shuffle(n, func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	return lines
+}
+
+func assertEmptyDir(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) > 0 {
+		t.Errorf("%d temp files left in %s, first: %s", len(entries), dir, entries[0].Name())
+	}
+}
+
+func TestSortManyRunsLimitsFanIn(t *testing.T) {
+	dir := t.TempDir()
+	lines := shuffledLines(20000)
+	sorter := &Sort{MaxMemory: 512, MaxFanIn: 4, TempDir: dir}
+	var out bytes.Buffer
+	if err := Run(strings.NewReader(strings.Join(lines, "\n")), &out, ScanLines, sorter); err != nil {
+		t.Fatal(err)
+	}
+	if sorter.Runs() <= sorter.MaxFanIn {
+		t.Fatalf("only %d runs spilled, test does not exercise multi-pass merge", sorter.Runs())
+	}
+	got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	want := slices.Sorted(slices.Values(lines))
+	if !slices.Equal(got, want) {
+		t.Errorf("output not sorted: %d records, first %q", len(got), got[:3])
+	}
+	assertEmptyDir(t, dir)
+}
+
+func TestSortReverse(t *testing.T) {
+	var out bytes.Buffer
+	sorter := &Sort{MaxMemory: 64, MaxFanIn: 2, Reverse: true, TempDir: t.TempDir()}
+	if err := Run(strings.NewReader("b\nc\na\nd\ne\n"), &out, ScanLines, sorter); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); got != "e\nd\nc\nb\na\n" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestRunCleansUpAfterFailure(t *testing.T) {
+	var packed bytes.Buffer
+	zw := gzip.NewWriter(&packed)
+	zw.Write([]byte(strings.Join(shuffledLines(50000), "\n")))
+	zw.Close()
+	truncated := packed.Bytes()[:packed.Len()/2]
+
+	dir := t.TempDir()
+	sorter := &Sort{MaxMemory: 1 << 10, TempDir: dir}
+	err := Run(bytes.NewReader(truncated), &bytes.Buffer{}, ScanLines, &Uniq{}, sorter, &Count{})
+	if err == nil {
+		t.Fatal("expected error for truncated gzip input")
+	}
+	if sorter.Runs() == 0 {
+		t.Fatal("no runs spilled before the error, test does not exercise cleanup")
+	}
+	assertEmptyDir(t, dir)
+}
+
+// failing zwraca błąd po n rekordach, np. jak etap zapisujący na pełny dysk.
+type failing struct{ n int }
+
+func (f *failing) Push(rec string, emit Emit) error {
+	if f.n--; f.n < 0 {
+		return fmt.Errorf("disk full")
+	}
+	return emit(rec)
+}
+
+func (f *failing) Close(Emit) error { return nil }
+
+func TestRunClosesStagesWhenPushFails(t *testing.T) {
+	dir := t.TempDir()
+	sorter := &Sort{MaxMemory: 256, TempDir: dir}
+	input := strings.Join(shuffledLines(5000), "\n")
+	err := Run(strings.NewReader(input), &bytes.Buffer{}, ScanLines, &failing{n: 1000}, sorter)
+	if err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Fatalf("got %v, want disk full", err)
+	}
+	if sorter.Runs() == 0 {
+		t.Fatal("no runs spilled before the error, test does not exercise cleanup")
+	}
+	assertEmptyDir(t, dir)
+}
+
+func TestScanCSVRecords(t *testing.T) {
+	sc := NewScanner(strings.NewReader("a,b\r\n1,\"x\ny\"\n2,\"\"\"q\"\"\"\n"), ScanCSVRecords, 0)
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+	want := []string{"a,b", "1,\"x\ny\"", "2,\"\"\"q\"\"\""}
+	if err := sc.Err(); err != nil || !slices.Equal(got, want) {
+		t.Errorf("got %q, %v; want %q", got, err, want)
+	}
+
+	sc = NewScanner(strings.NewReader("1,\"open"), ScanCSVRecords, 0)
+	for sc.Scan() {
+	}
+	if sc.Err() == nil {
+		t.Error("unterminated quote not reported")
+	}
+}
@@ -0,0 +1,161 @@
+package tictactoe
+
+import (
+	"math"
+	"sort"
+)
+
+const winScore = 1 << 20
+
+/*
+AI wybiera ruchy algorytmem minimax z odcinaniem alfa-beta.
+Minimax zakłada, że przeciwnik zawsze gra optymalnie: gracz maksymalizuje swój wynik, a przeciwnik go minimalizuje.
+Odcinanie alfa-beta pomija gałęzie, które i tak nie mogą zmienić decyzji, więc wynik jest ten sam, ale liczony znacznie szybciej.
+
+Przy MaxDepth == 0 przeszukiwane jest całe drzewo gry, więc na planszy 3×3 AI jest nie do pokonania.
+Na większych planszach pełne przeszukiwanie jest zbyt kosztowne, dlatego warto ustawić MaxDepth;
+po osiągnięciu limitu pozycja jest oceniana heurystycznie na podstawie otwartych linii.
+*/
+type AI struct {
+	MaxDepth int
+}
+
+type search struct {
+	me       Mark
+	maxDepth int
+	lines    [][]Move
+}
+
+// BestMove zwraca najlepszy ruch dla gracza, którego jest kolej.
+func (ai AI) BestMove(g *Game) (Move, error) {
+	if g.Over() {
+		return Move{}, ErrGameOver
+	}
+
+	b := g.board.Clone()
+	s := &search{me: g.turn, maxDepth: ai.MaxDepth, lines: Lines(b.n, b.k)}
+
+	alpha, beta := math.MinInt, math.MaxInt
+	bestScore := math.MinInt
+	var best Move
+	for _, m := range s.candidates(b) {
+		b.set(m, s.me)
+		score := s.minimax(b, m, s.me.Opponent(), 1, alpha, beta)
+		b.set(m, Empty)
+		if score > bestScore {
+			bestScore, best = score, m
+		}
+		alpha = max(alpha, score)
+	}
+	return best, nil
+}
+
+func (s *search) minimax(b *Board, last Move, turn Mark, depth, alpha, beta int) int {
+	if b.lineThrough(last) != nil {
+		// Szybsza wygrana (i wolniejsza przegrana) jest lepsza, stąd poprawka o głębokość.
+		if b.At(last) == s.me {
+			return winScore - depth
+		}
+		return -winScore + depth
+	}
+	if b.Full() {
+		return 0
+	}
+	if s.maxDepth > 0 && depth >= s.maxDepth {
+		return s.evaluate(b)
+	}
+
+	maximizing := turn == s.me
+	best := math.MaxInt
+	if maximizing {
+		best = math.MinInt
+	}
+	for _, m := range s.candidates(b) {
+		b.set(m, turn)
+		score := s.minimax(b, m, turn.Opponent(), depth+1, alpha, beta)
+		b.set(m, Empty)
+
+		if maximizing {
+			best = max(best, score)
+			alpha = max(alpha, score)
+		} else {
+			best = min(best, score)
+			beta = min(beta, score)
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	return best
+}
+
+/*
+evaluate ocenia pozycję bez dalszego przeszukiwania: każda linia zajęta tylko przez jednego gracza
+jest warta tym więcej, im więcej jego znaków już zawiera.
+*/
+func (s *search) evaluate(b *Board) int {
+	score := 0
+	for _, line := range s.lines {
+		mine, theirs := 0, 0
+		for _, m := range line {
+			switch b.At(m) {
+			case s.me:
+				mine++
+			case s.me.Opponent():
+				theirs++
+			}
+		}
+		switch {
+		case mine > 0 && theirs == 0:
+			score += pow10(mine)
+		case theirs > 0 && mine == 0:
+			score -= pow10(theirs)
+		}
+	}
+	return score
+}
+
+func pow10(n int) int {
+	r := 1
+	for range n {
+		r *= 10
+	}
+	return r
+}
+
+/*
+candidates zwraca wolne pola posortowane od środka planszy, bo ruchy centralne zwykle są najlepsze,
+a dobra kolejność ruchów sprawia, że odcinanie alfa-beta działa wcześniej.
+Przy ograniczonej głębokości rozważane są tylko pola sąsiadujące z już postawionymi znakami.
+*/
+func (s *search) candidates(b *Board) []Move {
+	moves := b.EmptyCells()
+	if s.maxDepth > 0 && len(moves) < len(b.cells) {
+		near := moves[:0:0]
+		for _, m := range moves {
+			if b.hasNeighbour(m) {
+				near = append(near, m)
+			}
+		}
+		moves = near
+	}
+
+	center := float64(b.n-1) / 2
+	dist := func(m Move) float64 {
+		return math.Abs(float64(m.Row)-center) + math.Abs(float64(m.Col)-center)
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return dist(moves[i]) < dist(moves[j]) })
+	return moves
+}
+
+func (b *Board) hasNeighbour(m Move) bool {
+	for dr := -1; dr <= 1; dr++ {
+		for dc := -1; dc <= 1; dc++ {
+			n := Move{m.Row + dr, m.Col + dc}
+			if (dr != 0 || dc != 0) && b.InBounds(n) && b.At(n) != Empty {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+/*
+Pakiet tictactoe jest silnikiem gry w kółko i krzyżyk na planszy N×N,
+na której wygrywa ten, kto pierwszy ułoży K znaków w rzędzie (poziomo, pionowo lub po skosie).
+*/
+package tictactoe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mark oznacza zawartość pola planszy.
+type Mark int8
+
+const (
+	Empty Mark = iota
+	X
+	O
+)
+
+func (m Mark) String() string {
+	switch m {
+	case X:
+		return "X"
+	case O:
+		return "O"
+	default:
+		return "_"
+	}
+}
+
+// Opponent zwraca znak przeciwnika.
+func (m Mark) Opponent() Mark {
+	switch m {
+	case X:
+		return O
+	case O:
+		return X
+	default:
+		return Empty
+	}
+}
+
+// Move to współrzędne pola liczone od zera.
+type Move struct {
+	Row, Col int
+}
+
+func (m Move) String() string {
+	return fmt.Sprintf("(%d, %d)", m.Row+1, m.Col+1)
+}
+
+/*
+Board przechowuje pola w jednym wycinku o długości n*n zamiast w [][]string,
+dzięki czemu kopiowanie planszy (potrzebne przy przeszukiwaniu) to jedno wywołanie copy.
+*/
+type Board struct {
+	n, k  int
+	cells []Mark
+}
+
+// NewBoard tworzy pustą planszę n×n, na której wygrywa k znaków w rzędzie.
+func NewBoard(n, k int) (*Board, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("board size must be positive, got %d", n)
+	}
+	if k < 1 || k > n {
+		return nil, fmt.Errorf("k must be between 1 and %d, got %d", n, k)
+	}
+	return &Board{n: n, k: k, cells: make([]Mark, n*n)}, nil
+}
+
+func (b *Board) Size() int { return b.n }
+
+func (b *Board) K() int { return b.k }
+
+func (b *Board) InBounds(m Move) bool {
+	return m.Row >= 0 && m.Row < b.n && m.Col >= 0 && m.Col < b.n
+}
+
+func (b *Board) At(m Move) Mark {
+	return b.cells[m.Row*b.n+m.Col]
+}
+
+func (b *Board) set(m Move, mark Mark) {
+	b.cells[m.Row*b.n+m.Col] = mark
+}
+
+// Full zwraca true, gdy na planszy nie ma już wolnych pól.
+func (b *Board) Full() bool {
+	for _, c := range b.cells {
+		if c == Empty {
+			return false
+		}
+	}
+	return true
+}
+
+// EmptyCells zwraca wolne pola w kolejności wierszy.
+func (b *Board) EmptyCells() []Move {
+	var moves []Move
+	for i, c := range b.cells {
+		if c == Empty {
+			moves = append(moves, Move{i / b.n, i % b.n})
+		}
+	}
+	return moves
+}
+
+func (b *Board) Clone() *Board {
+	c := &Board{n: b.n, k: b.k, cells: make([]Mark, len(b.cells))}
+	copy(c.cells, b.cells)
+	return c
+}
+
+// Kierunki, w których można ułożyć linię: poziomo, pionowo i po obu przekątnych.
+var directions = [4]Move{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+/*
+Lines zwraca wszystkie możliwe linie wygrywające na planszy n×n dla k znaków w rzędzie.
+Dla klasycznej planszy 3×3 jest ich 8: 3 wiersze, 3 kolumny i 2 przekątne.
+*/
+func Lines(n, k int) [][]Move {
+	var lines [][]Move
+	for row := 0; row < n; row++ {
+		for col := 0; col < n; col++ {
+			for _, d := range directions {
+				endRow, endCol := row+d.Row*(k-1), col+d.Col*(k-1)
+				if endRow < 0 || endRow >= n || endCol < 0 || endCol >= n {
+					continue
+				}
+				line := make([]Move, k)
+				for i := range k {
+					line[i] = Move{row + d.Row*i, col + d.Col*i}
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+/*
+lineThrough sprawdza, czy znak stojący na polu m tworzy linię długości co najmniej k.
+Wystarczy sprawdzić cztery kierunki przechodzące przez ostatni ruch, zamiast całej planszy.
+*/
+func (b *Board) lineThrough(m Move) []Move {
+	mark := b.At(m)
+	if mark == Empty {
+		return nil
+	}
+	for _, d := range directions {
+		line := []Move{m}
+		for _, sign := range [2]int{-1, 1} {
+			next := Move{m.Row + sign*d.Row, m.Col + sign*d.Col}
+			for b.InBounds(next) && b.At(next) == mark {
+				if sign < 0 {
+					line = append([]Move{next}, line...)
+				} else {
+					line = append(line, next)
+				}
+				next = Move{next.Row + sign*d.Row, next.Col + sign*d.Col}
+			}
+		}
+		if len(line) >= b.k {
+			return line
+		}
+	}
+	return nil
+}
+
+// Winner przeszukuje całą planszę i zwraca zwycięzcę oraz zwycięską linię.
+func (b *Board) Winner() (Mark, []Move) {
+	for i, c := range b.cells {
+		if c == Empty {
+			continue
+		}
+		if line := b.lineThrough(Move{i / b.n, i % b.n}); line != nil {
+			return c, line
+		}
+	}
+	return Empty, nil
+}
+
+func (b *Board) String() string {
+	w := len(fmt.Sprint(b.n))
+	var sb strings.Builder
+	sb.WriteString(strings.Repeat(" ", w))
+	for col := range b.n {
+		fmt.Fprintf(&sb, " %*d", w, col+1)
+	}
+	sb.WriteByte('\n')
+	for row := range b.n {
+		fmt.Fprintf(&sb, "%*d", w, row+1)
+		for col := range b.n {
+			fmt.Fprintf(&sb, " %*s", w, b.At(Move{row, col}))
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -0,0 +1,127 @@
+package tictactoe
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	for _, tc := range []struct{ n, k, want int }{
+		{3, 3, 8},
+		{4, 4, 10},
+		{5, 4, 28}, // po 2 w każdym z 5 wierszy i 5 kolumn oraz 4 przekątne w każdym kierunku
+	} {
+		lines := Lines(tc.n, tc.k)
+		if len(lines) != tc.want {
+			t.Errorf("Lines(%d, %d): %d lines, want %d", tc.n, tc.k, len(lines), tc.want)
+		}
+		for _, line := range lines {
+			for _, mark := range []Mark{X, O} {
+				b, err := NewBoard(tc.n, tc.k)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, m := range line {
+					b.set(m, mark)
+				}
+				got, gotLine := b.Winner()
+				if got != mark || !sameCells(gotLine, line) {
+					t.Errorf("%d×%d, k=%d, %v on %v: Winner() = %v %v", tc.n, tc.n, tc.k, mark, line, got, gotLine)
+				}
+				// Bez jednego pola linia nie wygrywa.
+				b.set(line[len(line)/2], Empty)
+				if got, _ := b.Winner(); got != Empty {
+					t.Errorf("%d×%d, k=%d, %v on %v without middle cell: Winner() = %v", tc.n, tc.n, tc.k, mark, line, got)
+				}
+			}
+		}
+	}
+}
+
+func sameCells(a, b []Move) bool {
+	cmp := func(x, y Move) int {
+		if x.Row != y.Row {
+			return x.Row - y.Row
+		}
+		return x.Col - y.Col
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.SortFunc(a, cmp)
+	slices.SortFunc(b, cmp)
+	return slices.Equal(a, b)
+}
+
+func TestPlayRejectsInvalidMoves(t *testing.T) {
+	g, err := NewGame(3, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Play(Move{1, 1}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		m    Move
+		want error
+	}{
+		{Move{1, 1}, ErrOccupied},
+		{Move{-1, 0}, ErrOutOfBounds},
+		{Move{0, 3}, ErrOutOfBounds},
+		{Move{3, 3}, ErrOutOfBounds},
+	} {
+		if err := g.Play(tc.m); !errors.Is(err, tc.want) {
+			t.Errorf("Play(%v) = %v, want %v", tc.m, err, tc.want)
+		}
+	}
+	if g.Turn() != O || len(g.Moves()) != 1 {
+		t.Errorf("rejected moves changed the game: turn %v, moves %v", g.Turn(), g.Moves())
+	}
+
+	for _, m := range []Move{{0, 0}, {1, 0}, {0, 1}, {2, 0}, {0, 2}} {
+		g.Play(m)
+	}
+	if g.Status() != OWins {
+		t.Fatalf("status = %v, want %v", g.Status(), OWins)
+	}
+	if err := g.Play(Move{2, 2}); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Play after the game is over = %v, want ErrGameOver", err)
+	}
+}
+
+// TestAINeverLoses rozgrywa z AI wszystkie możliwe partie na planszy 3×3, w których AI zaczyna albo odpowiada.
+func TestAINeverLoses(t *testing.T) {
+	for _, ai := range []Mark{X, O} {
+		g, err := NewGame(3, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		games := 0
+		var explore func()
+		explore = func() {
+			if g.Over() {
+				games++
+				if (ai == X && g.Status() == OWins) || (ai == O && g.Status() == XWins) {
+					t.Fatalf("AI playing %v lost: %v", ai, g.Moves())
+				}
+				return
+			}
+			if g.Turn() == ai {
+				m, err := AI{}.BestMove(g)
+				if err != nil {
+					t.Fatal(err)
+				}
+				g.Play(m)
+				explore()
+				g.Undo()
+				return
+			}
+			for _, m := range g.board.EmptyCells() {
+				g.Play(m)
+				explore()
+				g.Undo()
+			}
+		}
+		explore()
+		t.Logf("AI playing %v: %d games", ai, games)
+	}
+}
@@ -0,0 +1,113 @@
+package tictactoe
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+Błędy sentinel zwracane przy niepoprawnym ruchu.
+Można je rozpoznać za pomocą errors.Is, nawet gdy są opakowane w dodatkowy kontekst.
+*/
+var (
+	ErrOutOfBounds = errors.New("move is outside the board")
+	ErrOccupied    = errors.New("cell is already taken")
+	ErrGameOver    = errors.New("game is already over")
+)
+
+type Status int
+
+const (
+	InProgress Status = iota
+	XWins
+	OWins
+	Draw
+)
+
+func (s Status) String() string {
+	switch s {
+	case XWins:
+		return "X wins"
+	case OWins:
+		return "O wins"
+	case Draw:
+		return "draw"
+	default:
+		return "in progress"
+	}
+}
+
+// Game pilnuje kolejności ruchów i wykrywa koniec gry. Zaczyna zawsze X.
+type Game struct {
+	board  *Board
+	turn   Mark
+	moves  []Move
+	status Status
+	line   []Move
+}
+
+func NewGame(n, k int) (*Game, error) {
+	b, err := NewBoard(n, k)
+	if err != nil {
+		return nil, err
+	}
+	return &Game{board: b, turn: X}, nil
+}
+
+// Board zwraca kopię planszy, żeby nie dało się jej zmienić z pominięciem Play.
+func (g *Game) Board() *Board { return g.board.Clone() }
+
+func (g *Game) Turn() Mark { return g.turn }
+
+func (g *Game) Status() Status { return g.status }
+
+func (g *Game) Over() bool { return g.status != InProgress }
+
+// WinningLine zwraca pola zwycięskiej linii lub nil, gdy nikt nie wygrał.
+func (g *Game) WinningLine() []Move { return g.line }
+
+func (g *Game) Moves() []Move { return append([]Move(nil), g.moves...) }
+
+// Play wykonuje ruch gracza, którego jest kolej.
+func (g *Game) Play(m Move) error {
+	if g.Over() {
+		return ErrGameOver
+	}
+	if !g.board.InBounds(m) {
+		return fmt.Errorf("%v: %w", m, ErrOutOfBounds)
+	}
+	if g.board.At(m) != Empty {
+		return fmt.Errorf("%v: %w", m, ErrOccupied)
+	}
+
+	g.board.set(m, g.turn)
+	g.moves = append(g.moves, m)
+
+	if line := g.board.lineThrough(m); line != nil {
+		g.line = line
+		if g.turn == X {
+			g.status = XWins
+		} else {
+			g.status = OWins
+		}
+	} else if g.board.Full() {
+		g.status = Draw
+	}
+
+	g.turn = g.turn.Opponent()
+	return nil
+}
+
+// Undo cofa ostatni ruch.
+func (g *Game) Undo() bool {
+	if len(g.moves) == 0 {
+		return false
+	}
+	last := g.moves[len(g.moves)-1]
+	g.moves = g.moves[:len(g.moves)-1]
+	g.board.set(last, Empty)
+	g.turn = g.turn.Opponent()
+	g.status = InProgress
+	g.line = nil
+	return true
+}
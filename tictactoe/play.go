@@ -0,0 +1,146 @@
+package tictactoe
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrQuit jest zwracany, gdy gracz przerwie grę.
+var ErrQuit = errors.New("player quit")
+
+// Player wybiera kolejny ruch w danej grze.
+type Player interface {
+	NextMove(g *Game) (Move, error)
+}
+
+/*
+Human czyta ruchy z wejścia w postaci "wiersz kolumna" (numerowane od 1), np. "2 3".
+Niepoprawne dane nie kończą gry, tylko powodują ponowne zapytanie. "q" kończy grę.
+*/
+type Human struct {
+	in  *bufio.Scanner
+	out io.Writer
+}
+
+func NewHuman(in *bufio.Scanner, out io.Writer) *Human {
+	return &Human{in: in, out: out}
+}
+
+func (h *Human) NextMove(g *Game) (Move, error) {
+	for {
+		fmt.Fprintf(h.out, "%s> ", g.Turn())
+		if !h.in.Scan() {
+			if err := h.in.Err(); err != nil {
+				return Move{}, err
+			}
+			return Move{}, ErrQuit
+		}
+
+		line := strings.TrimSpace(h.in.Text())
+		if line == "q" || line == "quit" {
+			return Move{}, ErrQuit
+		}
+
+		m, err := parseMove(line)
+		if err != nil {
+			fmt.Fprintln(h.out, err)
+			continue
+		}
+		if !g.board.InBounds(m) {
+			fmt.Fprintf(h.out, "%v: %v\n", m, ErrOutOfBounds)
+			continue
+		}
+		if g.board.At(m) != Empty {
+			fmt.Fprintf(h.out, "%v: %v\n", m, ErrOccupied)
+			continue
+		}
+		return m, nil
+	}
+}
+
+func parseMove(line string) (Move, error) {
+	fields := strings.Fields(strings.ReplaceAll(line, ",", " "))
+	if len(fields) != 2 {
+		return Move{}, fmt.Errorf("expected \"row col\", got %q", line)
+	}
+	row, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid row %q", fields[0])
+	}
+	col, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid column %q", fields[1])
+	}
+	return Move{row - 1, col - 1}, nil
+}
+
+// Computer gra ruchami wybranymi przez AI i wypisuje je, żeby człowiek widział, co się stało.
+type Computer struct {
+	AI  AI
+	out io.Writer
+}
+
+func NewComputer(ai AI, out io.Writer) *Computer {
+	return &Computer{AI: ai, out: out}
+}
+
+func (c *Computer) NextMove(g *Game) (Move, error) {
+	m, err := c.AI.BestMove(g)
+	if err != nil {
+		return Move{}, err
+	}
+	fmt.Fprintf(c.out, "%s plays %v\n", g.Turn(), m)
+	return m, nil
+}
+
+// Play prowadzi grę do końca, na zmianę pytając graczy x i o o ruchy.
+func Play(g *Game, x, o Player, out io.Writer) (Status, error) {
+	for !g.Over() {
+		fmt.Fprint(out, g.board)
+
+		p := x
+		if g.Turn() == O {
+			p = o
+		}
+		m, err := p.NextMove(g)
+		if err != nil {
+			return g.Status(), err
+		}
+		if err := g.Play(m); err != nil {
+			return g.Status(), err
+		}
+	}
+
+	fmt.Fprint(out, g.board)
+	fmt.Fprintln(out, "Result:", g.Status())
+	return g.Status(), nil
+}
+
+// Config opisuje grę w terminalu. Computer == Empty oznacza grę dwóch ludzi.
+type Config struct {
+	N, K     int
+	Computer Mark
+	Depth    int
+}
+
+// RunTerminal uruchamia grę, czytając ruchy ludzi z in i wypisując planszę do out.
+func RunTerminal(in io.Reader, out io.Writer, cfg Config) (Status, error) {
+	g, err := NewGame(cfg.N, cfg.K)
+	if err != nil {
+		return InProgress, err
+	}
+
+	scanner := bufio.NewScanner(in)
+	var x, o Player = NewHuman(scanner, out), NewHuman(scanner, out)
+	switch cfg.Computer {
+	case X:
+		x = NewComputer(AI{MaxDepth: cfg.Depth}, out)
+	case O:
+		o = NewComputer(AI{MaxDepth: cfg.Depth}, out)
+	}
+	return Play(g, x, o, out)
+}
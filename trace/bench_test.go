@@ -0,0 +1,95 @@
+package trace
+
+import "testing"
+
+/*
+Benchmarki mierzą koszt defer (go test -bench . ./trace). Od Go 1.14 większość defer jest "otwarta"
+(ang. open-coded): kompilator wstawia wywołanie na końcu funkcji i kosztuje ono niewiele więcej niż zwykłe
+wywołanie. Nie dotyczy to defer w pętli - każdy taki defer trafia na stos odroczonych wywołań na stercie
+i wykonuje się dopiero na końcu funkcji, a nie na końcu iteracji.
+*/
+
+// sink zapobiega usunięciu przez kompilator obliczeń, których wynik nie byłby używany.
+var sink int
+
+//go:noinline
+func work(x int) int { return x*31 + 7 }
+
+func BenchmarkDirectCall(b *testing.B) {
+	for i := range b.N {
+		sink = work(i)
+	}
+}
+
+func BenchmarkNoDeferInFunc(b *testing.B) {
+	for i := range b.N {
+		func() {
+			sink = work(i)
+		}()
+	}
+}
+
+func BenchmarkDeferInFunc(b *testing.B) {
+	for i := range b.N {
+		func() {
+			defer func() { sink = work(i) }()
+		}()
+	}
+}
+
+// BenchmarkDeferInLoop odracza 1000 wywołań na operację.
+func BenchmarkDeferInLoop(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		func() {
+			for j := range 1000 {
+				defer func() { sink = work(j) }()
+			}
+		}()
+	}
+}
+
+// BenchmarkLoopWithoutDefer to ta sama pętla co w BenchmarkDeferInLoop, ale bez defer.
+func BenchmarkLoopWithoutDefer(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		func() {
+			for j := range 1000 {
+				sink = work(j)
+			}
+		}()
+	}
+}
+
+func BenchmarkRecoverNoPanic(b *testing.B) {
+	for i := range b.N {
+		func() {
+			defer func() { recover() }()
+			sink = work(i)
+		}()
+	}
+}
+
+func BenchmarkPanicRecover(b *testing.B) {
+	b.ReportAllocs()
+	for i := range b.N {
+		func() {
+			defer func() { recover() }()
+			panic(i)
+		}()
+	}
+}
+
+func BenchmarkTracerEnter(b *testing.B) {
+	b.ReportAllocs()
+	tr := New()
+	for i := range b.N {
+		func() {
+			defer tr.Enter("work")()
+			sink = work(i)
+		}()
+		if i%1024 == 0 {
+			tr.Reset() // drzewo rośnie z każdym wywołaniem, więc co jakiś czas je czyścimy
+		}
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Pakiet trace zapisuje wejścia i wyjścia z funkcji za pomocą defer i rysuje z nich drzewo wywołań z czasami.
+
+	func fib(n int) int {
+		defer tr.Enter("fib", n)()
+		...
+	}
+
+Enter jest wywoływane od razu (argumenty instrukcji defer są obliczane w chwili jej wykonania),
+a zwrócona funkcja - dopiero przy wyjściu z fib, także gdy fib panikuje. Dlatego jedno defer wystarcza,
+żeby zmierzyć czas i głębokość wywołania bez pilnowania każdego return.
+
+Tracer nie jest przeznaczony do używania z wielu gorutyn naraz - drzewo opisuje jeden stos wywołań.
+*/
+package trace
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Span to jedno wywołanie funkcji.
+type Span struct {
+	Name     string
+	Args     string
+	Depth    int
+	Start    time.Time
+	Duration time.Duration
+	Panic    any // wartość paniki, jeśli funkcja zakończyła się paniką
+	Children []*Span
+}
+
+func (s *Span) String() string {
+	return s.Name + "(" + s.Args + ")"
+}
+
+/*
+Tracer buduje drzewo wywołań. Live, jeśli ustawione, dostaje na bieżąco wiersze "→ f(1)" i "← f(1) 1.2µs"
+z wcięciem zależnym od głębokości. Now pozwala podmienić zegar, np. na stały w przykładach.
+*/
+type Tracer struct {
+	Live  io.Writer
+	Now   func() time.Time
+	roots []*Span
+	stack []*Span
+}
+
+func New() *Tracer {
+	return &Tracer{Now: time.Now}
+}
+
+/*
+Enter zapisuje wejście do funkcji name z argumentami args i zwraca funkcję zapisującą wyjście.
+Funkcja wyjścia wywołuje recover, żeby zobaczyć panikę, zapisuje ją w Span.Panic i ponawia panikę z tą samą wartością -
+recover działa tylko bezpośrednio w funkcji odroczonej, dlatego zwracamy ją do defer, a nie wywołujemy w środku Enter.
+*/
+func (t *Tracer) Enter(name string, args ...any) func() {
+	s := &Span{Name: name, Args: formatArgs(args), Depth: len(t.stack), Start: t.Now()}
+	if len(t.stack) == 0 {
+		t.roots = append(t.roots, s)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Children = append(parent.Children, s)
+	}
+	t.stack = append(t.stack, s)
+	t.live("→", s, "")
+	return func() {
+		s.Duration = t.Now().Sub(s.Start)
+		t.stack = t.stack[:len(t.stack)-1]
+		if v := recover(); v != nil {
+			s.Panic = v
+			t.live("←", s, fmt.Sprintf(" panic: %v", v))
+			panic(v)
+		}
+		t.live("←", s, "")
+	}
+}
+
+func (t *Tracer) live(arrow string, s *Span, suffix string) {
+	if t.Live == nil {
+		return
+	}
+	indent := strings.Repeat("  ", s.Depth)
+	if arrow == "→" {
+		fmt.Fprintf(t.Live, "%s%s %s\n", indent, arrow, s)
+		return
+	}
+	fmt.Fprintf(t.Live, "%s%s %s %v%s\n", indent, arrow, s, s.Duration, suffix)
+}
+
+// Roots zwraca wywołania najwyższego poziomu.
+func (t *Tracer) Roots() []*Span {
+	return t.roots
+}
+
+// Reset usuwa zapisane wywołania.
+func (t *Tracer) Reset() {
+	t.roots, t.stack = nil, nil
+}
+
+func formatArgs(args []any) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		if str, ok := a.(string); ok {
+			s[i] = fmt.Sprintf("%q", str)
+		} else {
+			s[i] = fmt.Sprint(a)
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+/*
+WriteTree rysuje drzewo wywołań:
+
+	fib(3) 2.1µs
+	├── fib(2) 900ns
+	│   ├── fib(1) 100ns
+	│   └── fib(0) 80ns
+	└── fib(1) 90ns
+
+Z durations == false czasy są pomijane, co daje powtarzalny wynik.
+*/
+func (t *Tracer) WriteTree(w io.Writer, durations bool) {
+	var walk func(s *Span, prefix, branch, next string)
+	walk = func(s *Span, prefix, branch, next string) {
+		line := prefix + branch + s.String()
+		if durations {
+			line += " " + s.Duration.String()
+		}
+		if s.Panic != nil {
+			line += fmt.Sprintf(" panic: %v", s.Panic)
+		}
+		fmt.Fprintln(w, line)
+		for i, c := range s.Children {
+			if i == len(s.Children)-1 {
+				walk(c, prefix+next, "└── ", "    ")
+			} else {
+				walk(c, prefix+next, "├── ", "│   ")
+			}
+		}
+	}
+	for _, root := range t.roots {
+		walk(root, "", "", "")
+	}
+}
+
+// Stat podsumowuje wszystkie wywołania funkcji o jednej nazwie. Dla funkcji rekurencyjnych Total liczy czas
+// zagnieżdżonych wywołań wielokrotnie, bo każde z nich mieści się w czasie wywołania nadrzędnego.
+type Stat struct {
+	Name     string
+	Calls    int
+	Total    time.Duration
+	Max      time.Duration
+	MaxDepth int
+}
+
+// Stats zwraca podsumowanie dla każdej nazwy funkcji, w kolejności pierwszego wywołania.
+func (t *Tracer) Stats() []Stat {
+	var stats []Stat
+	index := map[string]int{}
+	var walk func(s *Span)
+	walk = func(s *Span) {
+		i, ok := index[s.Name]
+		if !ok {
+			i = len(stats)
+			index[s.Name] = i
+			stats = append(stats, Stat{Name: s.Name})
+		}
+		st := &stats[i]
+		st.Calls++
+		st.Total += s.Duration
+		st.Max = max(st.Max, s.Duration)
+		st.MaxDepth = max(st.MaxDepth, s.Depth)
+		for _, c := range s.Children {
+			walk(c)
+		}
+	}
+	for _, root := range t.roots {
+		walk(root)
+	}
+	return stats
+}
+
+// Default to tracer używany przez funkcję Enter pakietu.
+var Default = New()
+
+// Enter to skrót dla Default.Enter: defer trace.Enter("f", x)().
+func Enter(name string, args ...any) func() {
+	return Default.Enter(name, args...)
+}
@@ -0,0 +1,283 @@
+/*
+Pakiet validate sprawdza struktury na podstawie tagów `validate:"..."` odczytywanych przez refleksję.
+
+	type Person struct {
+		Name string `validate:"required"`
+		Age  int    `validate:"min=0,max=150"`
+	}
+
+Reguły oddzielamy przecinkami. Dostępne reguły:
+
+	required    wartość nie może być wartością zerową (pusty string, 0, nil, ...)
+	min=N       liczby: wartość >= N; stringi: liczba znaków >= N; wycinki i mapy: długość >= N
+	max=N       analogicznie do min
+	oneof=a b c wartość (w postaci tekstowej, z użyciem fmt.Stringer) musi być jedną z podanych
+
+Walidacja nie kończy się na pierwszym błędzie - zbierane są wszystkie naruszenia,
+a każde z nich zawiera ścieżkę do pola zaczynającą się od nazwy typu sprawdzanej struktury,
+np. "Team.Users[2].Age" dla validate.Struct(Team{...}). Struktura anonimowa nie ma nazwy, więc ścieżka zaczyna się od pola.
+*/
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// FieldError opisuje jedno naruszenie reguły, podobnie jak argError opisuje jeden błędny argument.
+type FieldError struct {
+	Path    string
+	Rule    string
+	Param   string
+	Value   any
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+/*
+ValidationError zbiera wszystkie naruszenia znalezione w strukturze.
+Metoda Unwrap() []error sprawia, że errors.As potrafi wyciągnąć z niego również pojedynczy *FieldError.
+*/
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	noun := "errors"
+	if len(e.Fields) == 1 {
+		noun = "error"
+	}
+	return fmt.Sprintf("%d validation %s: %s", len(e.Fields), noun, strings.Join(msgs, "; "))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+// ErrInvalidTag oznacza błąd w samym tagu (np. nieznaną regułę), a nie w sprawdzanej wartości.
+var ErrInvalidTag = errors.New("invalid validate tag")
+
+/*
+Struct sprawdza strukturę (lub wskaźnik na nią), wchodząc rekurencyjnie w zagnieżdżone struktury,
+wskaźniki, wycinki i mapy. Zwraca nil, *ValidationError albo błąd opakowujący ErrInvalidTag.
+*/
+func Struct(v any) error {
+	c := &collector{seen: map[visit]bool{}}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return fmt.Errorf("validate: nil %T", v)
+		}
+		c.enter(rv)
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validate: expected struct, got %T", v)
+	}
+
+	if err := c.walk(rv, rv.Type().Name()); err != nil {
+		return err
+	}
+	if len(c.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: c.fields}
+}
+
+type collector struct {
+	fields []*FieldError
+	seen   map[visit]bool
+}
+
+// visit identyfikuje wskaźnik, mapę lub wycinek po adresie i typie, tak jak reflect.DeepEqual.
+type visit struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+	len int
+}
+
+/*
+enter zapamiętuje wskaźnik, mapę lub wycinek i zwraca false, jeśli już je odwiedzono. Dzięki temu walk kończy się
+dla struktur z cyklami (np. węzeł listy wskazujący sam na siebie), a wartość dostępna kilkoma drogami
+jest sprawdzana tylko raz - błędy są zgłaszane pod ścieżką, którą dotarliśmy do niej najpierw.
+*/
+func (c *collector) enter(v reflect.Value) bool {
+	key := visit{v.UnsafePointer(), v.Type(), 0}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if c.seen[key] {
+		return false
+	}
+	c.seen[key] = true
+	return true
+}
+
+func (c *collector) walk(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() && c.enter(v) {
+			return c.walk(v.Elem(), path)
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			return c.walk(v.Elem(), path)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			fieldPath := joinPath(path, f.Name)
+			if tag, ok := f.Tag.Lookup("validate"); ok {
+				if err := c.check(v.Field(i), fieldPath, tag); err != nil {
+					return err
+				}
+			}
+			if err := c.walk(v.Field(i), fieldPath); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && (v.IsNil() || !c.enter(v)) {
+			return nil
+		}
+		for i := range v.Len() {
+			if err := c.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() || !c.enter(v) {
+			return nil
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := c.walk(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func (c *collector) check(v reflect.Value, path, tag string) error {
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "" {
+			continue
+		}
+		fn, ok := rules[name]
+		if !ok {
+			return fmt.Errorf("%s: unknown rule %q: %w", path, name, ErrInvalidTag)
+		}
+		msg, err := fn(v, param)
+		if err != nil {
+			return fmt.Errorf("%s: rule %s: %v: %w", path, name, err, ErrInvalidTag)
+		}
+		if msg != "" {
+			c.fields = append(c.fields, &FieldError{
+				Path:    path,
+				Rule:    name,
+				Param:   param,
+				Value:   v.Interface(),
+				Message: msg,
+			})
+		}
+	}
+	return nil
+}
+
+/*
+Reguła zwraca komunikat, gdy wartość jej nie spełnia, albo pusty string, gdy wszystko jest w porządku.
+Błąd oznacza niepoprawny parametr reguły.
+*/
+type rule func(v reflect.Value, param string) (string, error)
+
+var rules = map[string]rule{
+	"required": required,
+	"min":      bound("at least", func(x, limit float64) bool { return x >= limit }),
+	"max":      bound("at most", func(x, limit float64) bool { return x <= limit }),
+	"oneof":    oneOf,
+}
+
+func required(v reflect.Value, _ string) (string, error) {
+	if v.IsZero() {
+		return "is required", nil
+	}
+	return "", nil
+}
+
+func bound(word string, ok func(x, limit float64) bool) rule {
+	return func(v reflect.Value, param string) (string, error) {
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid number %q", param)
+		}
+
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if !ok(float64(v.Int()), limit) {
+				return fmt.Sprintf("must be %s %s", word, param), nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if !ok(float64(v.Uint()), limit) {
+				return fmt.Sprintf("must be %s %s", word, param), nil
+			}
+		case reflect.Float32, reflect.Float64:
+			if !ok(v.Float(), limit) {
+				return fmt.Sprintf("must be %s %s", word, param), nil
+			}
+		case reflect.String:
+			if !ok(float64(utf8.RuneCountInString(v.String())), limit) {
+				return fmt.Sprintf("length must be %s %s", word, param), nil
+			}
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if !ok(float64(v.Len()), limit) {
+				return fmt.Sprintf("length must be %s %s", word, param), nil
+			}
+		default:
+			return "", fmt.Errorf("not supported for %s", v.Type())
+		}
+		return "", nil
+	}
+}
+
+func oneOf(v reflect.Value, param string) (string, error) {
+	options := strings.Fields(param)
+	if len(options) == 0 {
+		return "", errors.New("no options")
+	}
+	s := fmt.Sprint(v.Interface())
+	for _, o := range options {
+		if s == o {
+			return "", nil
+		}
+	}
+	return fmt.Sprintf("must be one of [%s]", strings.Join(options, " ")), nil
+}
@@ -0,0 +1,130 @@
+package validate
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+type node struct {
+	Name string `validate:"required"`
+	Next *node
+}
+
+type graph struct {
+	Name  string `validate:"required"`
+	Nodes map[string]*node
+	Any   []any
+}
+
+// paths zwraca ścieżki pól z błędu walidacji.
+func paths(t *testing.T, err error) []string {
+	t.Helper()
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("err = %v, want *ValidationError", err)
+	}
+	var out []string
+	for _, f := range ve.Fields {
+		out = append(out, f.Path)
+	}
+	return out
+}
+
+func TestCycles(t *testing.T) {
+	self := &node{}
+	self.Next = self
+
+	a, b := &node{Name: "a"}, &node{}
+	a.Next, b.Next = b, a
+
+	g := &graph{Nodes: map[string]*node{"self": self}}
+	g.Any = []any{g, g.Nodes, nil}
+	g.Any[2] = g.Any
+
+	for _, tc := range []struct {
+		name string
+		v    any
+		want []string
+	}{
+		{"self loop", self, []string{"node.Name"}},
+		{"two-node cycle", a, []string{"node.Next.Name"}},
+		{"map and slice cycles", g, []string{"graph.Name", "graph.Nodes[self].Name"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			done := make(chan error, 1)
+			go func() { done <- Struct(tc.v) }()
+			select {
+			case err := <-done:
+				if got := paths(t, err); !slices.Equal(got, tc.want) {
+					t.Errorf("paths = %v, want %v", got, tc.want)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Struct did not return for a cyclic value")
+			}
+		})
+	}
+}
+
+// TestSharedPointer sprawdza, że wartość dostępna dwiema drogami jest sprawdzana raz, pod pierwszą ścieżką.
+func TestSharedPointer(t *testing.T) {
+	shared := &node{}
+	type pair struct {
+		Left, Right *node
+	}
+	got := paths(t, Struct(pair{shared, shared}))
+	if want := []string{"pair.Left.Name"}; !slices.Equal(got, want) {
+		t.Errorf("paths = %v, want %v", got, want)
+	}
+}
+
+func TestRules(t *testing.T) {
+	type user struct {
+		Name string `validate:"required"`
+		Age  int    `validate:"min=0,max=150"`
+		Role string `validate:"oneof=guest admin"`
+	}
+	if err := Struct(user{"Ann", 30, "admin"}); err != nil {
+		t.Errorf("valid user: %v", err)
+	}
+	got := paths(t, Struct(&user{"", 200, "root"}))
+	if want := []string{"user.Name", "user.Age", "user.Role"}; !slices.Equal(got, want) {
+		t.Errorf("paths = %v, want %v", got, want)
+	}
+
+	type bad struct {
+		X int `validate:"between=1"`
+	}
+	if err := Struct(bad{}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("unknown rule: err = %v, want ErrInvalidTag", err)
+	}
+	if err := Struct((*user)(nil)); err == nil {
+		t.Error("nil pointer: err = nil")
+	}
+}
+
+// TestPathFormat sprawdza format ścieżek opisany w dokumentacji pakietu.
+func TestPathFormat(t *testing.T) {
+	type Person struct {
+		Age int `validate:"max=150"`
+	}
+	type Team struct {
+		Users []Person
+		Leads map[string]*Person
+	}
+	team := Team{
+		Users: []Person{{1}, {2}, {200}},
+		Leads: map[string]*Person{"ops": {300}},
+	}
+	want := []string{"Team.Users[2].Age", "Team.Leads[ops].Age"}
+	if got := paths(t, Struct(&team)); !slices.Equal(got, want) {
+		t.Errorf("paths = %q, want %q", got, want)
+	}
+	anon := struct {
+		Users []Person
+	}{[]Person{{200}}}
+	if got, want := paths(t, Struct(anon)), []string{"Users[0].Age"}; !slices.Equal(got, want) {
+		t.Errorf("anonymous struct paths = %q, want %q", got, want)
+	}
+}
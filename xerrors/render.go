@@ -0,0 +1,119 @@
+package xerrors
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+/*
+Tree rysuje łańcuch przyczyn jako drzewo, np.:
+
+	[unavailable] making tea {arg=4}
+	│   at lets-go/basics.makeTea (errors.go:52)
+	└── can't boil water
+
+Każdy węzeł pokazuje tylko własny komunikat, a nie cały połączony tekst Error().
+*/
+func Tree(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+	var sb strings.Builder
+	writeNode(&sb, err, "", "")
+	return sb.String()
+}
+
+func writeNode(sb *strings.Builder, err error, first, rest string) {
+	children := causes(err)
+
+	sb.WriteString(first)
+	sb.WriteString(ownMessage(err))
+	sb.WriteByte('\n')
+
+	if e, ok := err.(*Error); ok && len(e.stack) > 0 {
+		bar := "    "
+		if len(children) > 0 {
+			bar = "│   "
+		}
+		fmt.Fprintf(sb, "%s%sat %s\n", rest, bar, e.StackTrace()[0])
+	}
+
+	for i, child := range children {
+		if i == len(children)-1 {
+			writeNode(sb, child, rest+"└── ", rest+"    ")
+		} else {
+			writeNode(sb, child, rest+"├── ", rest+"│   ")
+		}
+	}
+}
+
+/*
+ownMessage zwraca komunikat węzła bez komunikatów przyczyn.
+Dla błędów z fmt.Errorf("ctx: %w", err) odcinamy końcówkę będącą komunikatem przyczyny.
+*/
+func ownMessage(err error) string {
+	if e, ok := err.(*Error); ok {
+		msg := fmt.Sprintf("[%s] %s", e.code, e.msg)
+		if len(e.fields) > 0 {
+			msg += " " + formatFields(e.fields)
+		}
+		return msg
+	}
+
+	msg := err.Error()
+	if cs := causes(err); len(cs) == 1 {
+		msg = strings.TrimSuffix(msg, cs[0].Error())
+		msg = strings.TrimSuffix(strings.TrimSpace(msg), ":")
+		if msg == "" {
+			msg = fmt.Sprintf("(%T)", err)
+		}
+	} else if len(cs) > 1 {
+		msg = fmt.Sprintf("(%T with %d errors)", err, len(cs))
+	}
+	return msg
+}
+
+type jsonError struct {
+	Code    Code           `json:"code,omitempty"`
+	Type    string         `json:"type,omitempty"`
+	Message string         `json:"message"`
+	Fields  map[string]any `json:"fields,omitempty"`
+	Stack   []string       `json:"stack,omitempty"`
+	Causes  []jsonError    `json:"causes,omitempty"`
+}
+
+func toJSON(err error) jsonError {
+	j := jsonError{Message: ownMessage(err)}
+	if e, ok := err.(*Error); ok {
+		j.Code = e.code
+		j.Message = e.msg
+		if len(e.fields) > 0 {
+			j.Fields = make(map[string]any, len(e.fields))
+			for _, f := range e.fields {
+				j.Fields[f.Key] = f.Value
+			}
+		}
+		for _, f := range e.StackTrace() {
+			j.Stack = append(j.Stack, f.String())
+		}
+	} else {
+		j.Type = fmt.Sprintf("%T", err)
+	}
+	for _, c := range causes(err) {
+		j.Causes = append(j.Causes, toJSON(c))
+	}
+	return j
+}
+
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toJSON(e))
+}
+
+// JSON koduje dowolny błąd wraz z łańcuchem przyczyn; błędy spoza pakietu opisywane są typem i komunikatem.
+func JSON(err error) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return json.MarshalIndent(toJSON(err), "", "  ")
+}
@@ -0,0 +1,224 @@
+/*
+Pakiet xerrors dostarcza błędy strukturalne do kodu produkcyjnego.
+
+Oprócz komunikatu każdy błąd niesie:
+  - kod (Code) przeznaczony dla maszyn, np. do mapowania na status HTTP lub metryki,
+  - pola klucz/wartość z kontekstem, np. "arg", 42,
+  - stos wywołań z miejsca, w którym błąd został utworzony,
+  - przyczynę (ang. cause), dzięki czemu działa z errors.Is i errors.As tak samo jak zawijanie przez %w.
+
+Błąd można wypisać jako drzewo czytelne dla człowieka (Tree) albo jako JSON (JSON lub json.Marshal).
+*/
+package xerrors
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+type Code string
+
+const (
+	CodeUnknown         Code = "unknown"
+	CodeInvalidArgument Code = "invalid_argument"
+	CodeNotFound        Code = "not_found"
+	CodeUnavailable     Code = "unavailable"
+	CodeInternal        Code = "internal"
+)
+
+type Field struct {
+	Key   string
+	Value any
+}
+
+type Error struct {
+	code   Code
+	msg    string
+	fields []Field
+	stack  []uintptr
+	cause  error
+}
+
+// Maksymalna liczba ramek zapamiętywanych w stosie.
+const maxDepth = 32
+
+/*
+New tworzy nowy błąd. Argumenty kv to pary klucz/wartość, tak jak w log/slog:
+New(CodeNotFound, "no tea", "kind", "green").
+*/
+func New(code Code, msg string, kv ...any) error {
+	return newError(code, msg, nil, kv)
+}
+
+/*
+Wrap dodaje kod, komunikat i kontekst do istniejącego błędu, zachowując go jako przyczynę.
+Dla err == nil zwraca nil, więc można pisać `return xerrors.Wrap(err, ...)` bez dodatkowego if.
+*/
+func Wrap(err error, code Code, msg string, kv ...any) error {
+	if err == nil {
+		return nil
+	}
+	return newError(code, msg, err, kv)
+}
+
+func newError(code Code, msg string, cause error, kv []any) *Error {
+	pcs := make([]uintptr, maxDepth)
+	// Pomijamy runtime.Callers, newError oraz New/Wrap.
+	n := runtime.Callers(3, pcs)
+	return &Error{
+		code:   code,
+		msg:    msg,
+		fields: toFields(kv),
+		stack:  pcs[:n],
+		cause:  cause,
+	}
+}
+
+func toFields(kv []any) []Field {
+	var fields []Field
+	for len(kv) > 0 {
+		if len(kv) == 1 {
+			fields = append(fields, Field{"!BADKEY", kv[0]})
+			break
+		}
+		key, ok := kv[0].(string)
+		if !ok {
+			key = fmt.Sprint(kv[0])
+		}
+		fields = append(fields, Field{key, kv[1]})
+		kv = kv[2:]
+	}
+	return fields
+}
+
+// Error łączy komunikaty w łańcuchu tak samo jak fmt.Errorf("%s: %w", ...).
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.msg
+	}
+	if e.msg == "" {
+		return e.cause.Error()
+	}
+	return e.msg + ": " + e.cause.Error()
+}
+
+func (e *Error) Unwrap() error { return e.cause }
+
+func (e *Error) Code() Code { return e.code }
+
+func (e *Error) Message() string { return e.msg }
+
+func (e *Error) Fields() []Field { return append([]Field(nil), e.fields...) }
+
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+func (f Frame) String() string {
+	return fmt.Sprintf("%s (%s:%d)", f.Function, f.File, f.Line)
+}
+
+// StackTrace zwraca stos wywołań z chwili utworzenia błędu, od najbardziej zagnieżdżonej funkcji.
+func (e *Error) StackTrace() []Frame {
+	var frames []Frame
+	it := runtime.CallersFrames(e.stack)
+	for {
+		f, more := it.Next()
+		frames = append(frames, Frame{f.Function, f.File, f.Line})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+/*
+Format pozwala wypisać błąd na dwa sposoby:
+%v i %s dają jednoliniowy komunikat, a %+v całe drzewo przyczyn wraz ze stosem wywołań.
+*/
+func (e *Error) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		fmt.Fprint(s, Tree(e))
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		fmt.Fprint(s, e.Error())
+	}
+}
+
+// CodeOf zwraca kod pierwszego *Error w łańcuchu albo CodeUnknown.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return CodeUnknown
+}
+
+// HasCode sprawdza, czy którykolwiek błąd w łańcuchu ma podany kod.
+func HasCode(err error, code Code) bool {
+	found := false
+	walk(err, func(err error) bool {
+		if e, ok := err.(*Error); ok && e.code == code {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// FieldsOf zbiera pola ze wszystkich *Error w łańcuchu, od zewnętrznego do najgłębszego.
+func FieldsOf(err error) []Field {
+	var fields []Field
+	walk(err, func(err error) bool {
+		if e, ok := err.(*Error); ok {
+			fields = append(fields, e.fields...)
+		}
+		return true
+	})
+	return fields
+}
+
+/*
+walk odwiedza błąd i wszystkie jego przyczyny w głąb, obsługując zarówno Unwrap() error,
+jak i Unwrap() []error (errors.Join). Zatrzymuje się, gdy visit zwróci false.
+*/
+func walk(err error, visit func(error) bool) bool {
+	if err == nil {
+		return true
+	}
+	if !visit(err) {
+		return false
+	}
+	for _, cause := range causes(err) {
+		if !walk(cause, visit) {
+			return false
+		}
+	}
+	return true
+}
+
+func causes(err error) []error {
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		if c := u.Unwrap(); c != nil {
+			return []error{c}
+		}
+	case interface{ Unwrap() []error }:
+		return u.Unwrap()
+	}
+	return nil
+}
+
+func formatFields(fields []Field) string {
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		parts[i] = fmt.Sprintf("%s=%v", f.Key, f.Value)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}